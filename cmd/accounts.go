@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/sync/semaphore"
+)
+
+type accountResult struct {
+	ID   string
+	Nuke *Nuke
+	Err  error
+}
+
+// RunMultiAccount runs the normal nuke flow for every account from the config
+// or the organizational unit. The accounts are accessed by assuming the role
+// given by --assume-role-name with the provided credentials.
+func RunMultiAccount(ctx context.Context, params NukeParameters, creds awsutil.Credentials, config *config.Nuke) error {
+	organization := &creds
+	if params.OrganizationRoleARN != "" {
+		organization = creds.WithAssumedRole(params.OrganizationRoleARN)
+	}
+
+	accountIDs, err := resolveAccountIDs(params, organization, config)
+	if err != nil {
+		return err
+	}
+
+	if len(accountIDs) == 0 {
+		return fmt.Errorf("There are no accounts to nuke.")
+	}
+
+	fmt.Fprintf(Console, "Nuking %d accounts by assuming the role '%s': %v\n\n",
+		len(accountIDs), params.AssumeRoleName, accountIDs)
+
+	LogAccounts = true
+	defer func() { LogAccounts = false }()
+
+	sem := semaphore.NewWeighted(int64(params.ParallelAccounts))
+	wg := new(sync.WaitGroup)
+
+	results := make([]accountResult, len(accountIDs))
+	for i, accountID := range accountIDs {
+		results[i] = accountResult{ID: accountID, Err: fmt.Errorf("skipped because of interrupt")}
+
+		if sem.Acquire(ctx, 1) != nil {
+			continue
+		}
+		wg.Add(1)
+
+		go func(i int, accountID string) {
+			defer wg.Done()
+			defer sem.Release(1)
+
+			results[i] = nukeAccount(ctx, params, &creds, organization, config, accountID)
+		}(i, accountID)
+	}
+
+	wg.Wait()
+
+	failed := 0
+	fmt.Fprintln(Console)
+	for _, result := range results {
+		if result.Err != nil {
+			failed++
+		}
+
+		printAccountResult(result)
+	}
+	fmt.Fprintln(Console)
+
+	if failed > 0 {
+		return fmt.Errorf("failed to nuke %d of %d accounts", failed, len(results))
+	}
+
+	return nil
+}
+
+func resolveAccountIDs(params NukeParameters, creds *awsutil.Credentials, config *config.Nuke) ([]string, error) {
+	if params.OrganizationalUnit != "" {
+		accountIDs, err := creds.ListOrganizationalUnitAccounts(params.OrganizationalUnit)
+		if err != nil {
+			return nil, err
+		}
+
+		sort.Strings(accountIDs)
+		return accountIDs, nil
+	}
+
+	accountIDs := []string{}
+	for accountID := range config.Accounts {
+		accountIDs = append(accountIDs, accountID)
+	}
+	sort.Strings(accountIDs)
+
+	return accountIDs, nil
+}
+
+func nukeAccount(ctx context.Context, params NukeParameters, creds, organization *awsutil.Credentials, config *config.Nuke, accountID string) accountResult {
+	result := accountResult{ID: accountID}
+
+	accountCreds, err := creds.ForAccount(accountID, params.AssumeRoleName)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	account, err := awsutil.NewAccount(*accountCreds, config.CustomEndpoints)
+	if err != nil {
+		result.Err = err
+		return result
+	}
+
+	if account.ID() != accountID {
+		result.Err = fmt.Errorf("Assuming the role in account %s resulted in account %s. Aborting.",
+			accountID, account.ID())
+		return result
+	}
+
+	n := NewNuke(params, *account)
+	n.Config = config
+	n.Organization = organization
+	result.Nuke = n
+
+	result.Err = n.Run(ctx)
+	if result.Err != nil {
+		logrus.Errorf("Nuking account %s failed: %v", accountID, result.Err)
+	}
+
+	return result
+}
+
+func printAccountResult(result accountResult) {
+	var (
+		failed, skipped, finished int
+		alias                     string
+	)
+
+	if result.Nuke != nil {
+		failed = result.Nuke.items.Count(ItemStateFailed)
+		skipped = result.Nuke.items.Count(ItemStateFiltered)
+		finished = result.Nuke.items.Count(ItemStateFinished)
+		alias = strings.Join(result.Nuke.Account.Aliases(), ", ")
+	}
+
+	if OutputFormat == OutputFormatJSON {
+		event := Event{
+			Event:   "account-complete",
+			Account: result.ID,
+			Counts:  map[string]int{"failed": failed, "skipped": skipped, "finished": finished},
+		}
+		if result.Err != nil {
+			event.Reason = result.Err.Error()
+		}
+		LogEvent(event)
+		return
+	}
+
+	if result.Err != nil {
+		ReasonError.Fprintf(Console, "%s (%s) - %d failed, %d skipped, %d finished - %v\n",
+			result.ID, alias, failed, skipped, finished, result.Err)
+		return
+	}
+
+	ReasonSuccess.Fprintf(Console, "%s (%s) - %d failed, %d skipped, %d finished\n",
+		result.ID, alias, failed, skipped, finished)
+}
@@ -0,0 +1,59 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
+	"github.com/spf13/cobra"
+)
+
+func NewApplyCommand(params *NukeParameters, creds *awsutil.Credentials, defaultRegion *string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "apply <plan-file>",
+		Short: "removes exactly the resources of a plan created with --out",
+		Long: `Rescans the resource types of the plan and removes only the resources ` +
+			`which are listed in it. Resources created after the plan are left untouched. ` +
+			`Refuses to run if the account or the config file differ from the ones used ` +
+			`to create the plan.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+
+			params := *params
+			params.NoDryRun = true
+
+			err = params.Validate()
+			if err != nil {
+				return err
+			}
+
+			if params.AssumeRoleName != "" {
+				return fmt.Errorf("Plan files are not supported when nuking multiple accounts.")
+			}
+
+			SetOutputFormat(params.Output)
+
+			cmd.SilenceUsage = true
+
+			ctx, cancel := SignalContext(context.Background())
+			defer cancel()
+
+			plan, err := LoadPlan(args[0])
+			if err != nil {
+				return err
+			}
+
+			n, err := NewNukeFromFlags(params, *creds, *defaultRegion)
+			if err != nil {
+				return err
+			}
+
+			n.Plan = plan
+
+			return n.Run(ctx)
+		},
+	}
+
+	return cmd
+}
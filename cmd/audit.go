@@ -0,0 +1,190 @@
+package cmd
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+	"github.com/rebuy-de/aws-nuke/v2/resources"
+	"github.com/sirupsen/logrus"
+)
+
+// AuditManifest records what happened to every resource of a run. It is
+// stored together with its SHA-256 checksum, so later modifications can be
+// detected.
+type AuditManifest struct {
+	RunID      string        `json:"run-id"`
+	AccountID  string        `json:"account-id"`
+	Operator   AuditOperator `json:"operator"`
+	ConfigHash string        `json:"config-hash,omitempty"`
+	DryRun     bool          `json:"dry-run"`
+	StartedAt  time.Time     `json:"started-at"`
+	FinishedAt time.Time     `json:"finished-at"`
+	Error      string        `json:"error,omitempty"`
+	Items      []AuditItem   `json:"items"`
+}
+
+// AuditOperator is the identity which was used for the run, according to
+// STS GetCallerIdentity.
+type AuditOperator struct {
+	ARN    string `json:"arn,omitempty"`
+	UserID string `json:"user-id,omitempty"`
+}
+
+type AuditItem struct {
+	Region     string           `json:"region"`
+	Type       string           `json:"resource-type"`
+	ID         string           `json:"id,omitempty"`
+	Properties types.Properties `json:"properties,omitempty"`
+	State      ItemState        `json:"state"`
+	Reason     string           `json:"reason,omitempty"`
+	Snapshot   string           `json:"snapshot,omitempty"`
+	FinishedAt *time.Time       `json:"finished-at,omitempty"`
+}
+
+func NewAuditManifest(n *Nuke, startedAt time.Time, runErr error) *AuditManifest {
+	manifest := &AuditManifest{
+		RunID:     n.RunID,
+		AccountID: n.Account.ID(),
+		Operator: AuditOperator{
+			ARN:    n.Account.CallerARN(),
+			UserID: n.Account.CallerUserID(),
+		},
+		DryRun:     !n.Parameters.NoDryRun,
+		StartedAt:  startedAt.UTC(),
+		FinishedAt: time.Now().UTC(),
+		Items:      []AuditItem{},
+	}
+
+	if n.Config != nil {
+		manifest.ConfigHash = n.Config.Hash()
+	}
+
+	if runErr != nil {
+		manifest.Error = runErr.Error()
+	}
+
+	for _, item := range n.items {
+		auditItem := AuditItem{
+			Region:   item.Region.Name,
+			Type:     item.Type,
+			State:    item.State,
+			Reason:   item.Reason,
+			Snapshot: item.Snapshot,
+		}
+
+		if stringer, ok := item.Resource.(resources.LegacyStringer); ok {
+			auditItem.ID = stringer.String()
+		}
+
+		if getter, ok := item.Resource.(resources.ResourcePropertyGetter); ok {
+			auditItem.Properties = getter.Properties()
+		}
+
+		if !item.FinishedAt.IsZero() {
+			finishedAt := item.FinishedAt.UTC()
+			auditItem.FinishedAt = &finishedAt
+		}
+
+		manifest.Items = append(manifest.Items, auditItem)
+	}
+
+	return manifest
+}
+
+// Encode returns the manifest and the content of the matching checksum file,
+// which has the format of sha256sum.
+func (m *AuditManifest) Encode(name string) ([]byte, []byte, error) {
+	raw, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, nil, err
+	}
+	raw = append(raw, '\n')
+
+	sum := sha256.Sum256(raw)
+	checksum := fmt.Sprintf("%s  %s\n", hex.EncodeToString(sum[:]), name)
+
+	return raw, []byte(checksum), nil
+}
+
+// WriteAudit stores the manifest of the run, if an audit sink is configured.
+func (n *Nuke) WriteAudit(startedAt time.Time, runErr error) error {
+	audit := n.Config.Audit
+	if !audit.Enabled() {
+		return nil
+	}
+
+	name := fmt.Sprintf("aws-nuke-%s-%s.json", n.Account.ID(), n.RunID)
+	raw, checksum, err := NewAuditManifest(n, startedAt, runErr).Encode(name)
+	if err != nil {
+		return err
+	}
+
+	if audit.Directory != "" {
+		err = os.MkdirAll(audit.Directory, 0700)
+		if err != nil {
+			return err
+		}
+
+		file := filepath.Join(audit.Directory, name)
+		err = os.WriteFile(file, raw, 0600)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile(file+".sha256", checksum, 0600)
+		if err != nil {
+			return err
+		}
+
+		logrus.Infof("Audit manifest written to %s", file)
+	}
+
+	if audit.Bucket != "" {
+		region := audit.Region
+		if region == "" {
+			region = awsutil.DefaultRegionID
+		}
+
+		sess, err := n.Account.NewSession(region, "s3")
+		if err != nil {
+			return err
+		}
+
+		svc := s3.New(sess)
+		key := path.Join(audit.KeyPrefix, name)
+		objects := []struct {
+			key  string
+			body []byte
+		}{
+			{key, raw},
+			{key + ".sha256", checksum},
+		}
+
+		for _, object := range objects {
+			_, err = svc.PutObject(&s3.PutObjectInput{
+				Bucket: aws.String(audit.Bucket),
+				Key:    aws.String(object.key),
+				Body:   bytes.NewReader(object.body),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to upload audit manifest to s3://%s/%s: %w",
+					audit.Bucket, object.key, err)
+			}
+		}
+
+		logrus.Infof("Audit manifest uploaded to s3://%s/%s", audit.Bucket, key)
+	}
+
+	return nil
+}
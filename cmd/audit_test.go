@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+)
+
+func TestWriteAudit(t *testing.T) {
+	dir := t.TempDir()
+	region := &Region{Name: "eu-west-1"}
+
+	n := &Nuke{
+		RunID:      "20261018T120000Z",
+		Parameters: NukeParameters{NoDryRun: true},
+		Config: &config.Nuke{
+			Audit: config.Audit{Directory: dir},
+		},
+		items: Queue{
+			{Region: region, Type: "EC2Instance", State: ItemStateFinished, Resource: &testResource{id: "i-1"}, FinishedAt: time.Now()},
+			{Region: region, Type: "EC2Instance", State: ItemStateFailed, Reason: "boom", Resource: &testResource{id: "i-2"}},
+			{Region: region, Type: "IAMRole", State: ItemStateFiltered, Reason: "filtered by config", Resource: &testResource{id: "admin"}},
+		},
+	}
+
+	err := n.WriteAudit(time.Now(), errors.New("failed"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	file := filepath.Join(dir, "aws-nuke--20261018T120000Z.json")
+	raw, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checksum, err := os.ReadFile(file + ".sha256")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	sum := sha256.Sum256(raw)
+	if !strings.HasPrefix(string(checksum), hex.EncodeToString(sum[:])+"  ") {
+		t.Errorf("Checksum does not match the manifest: %s", string(checksum))
+	}
+
+	manifest := new(AuditManifest)
+	err = json.Unmarshal(raw, manifest)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if manifest.DryRun || manifest.Error != "failed" || len(manifest.Items) != 3 {
+		t.Fatalf("Wrong manifest: %s", string(raw))
+	}
+
+	want := []struct {
+		id     string
+		state  ItemState
+		reason string
+	}{
+		{"i-1", ItemStateFinished, ""},
+		{"i-2", ItemStateFailed, "boom"},
+		{"admin", ItemStateFiltered, "filtered by config"},
+	}
+
+	for i, w := range want {
+		have := manifest.Items[i]
+		if have.ID != w.id || have.State != w.state || have.Reason != w.reason {
+			t.Errorf("Wrong item %d. Want: %+v. Have: %+v", i, w, have)
+		}
+	}
+
+	if manifest.Items[0].FinishedAt == nil || manifest.Items[1].FinishedAt != nil {
+		t.Errorf("Wrong finish times in manifest: %s", string(raw))
+	}
+}
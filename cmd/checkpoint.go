@@ -0,0 +1,137 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+)
+
+// A Checkpoint persists the state of the queue, so an interrupted run can be
+// resumed without scanning resource types again, which are already done.
+type Checkpoint struct {
+	AccountID     string           `json:"account-id"`
+	ConfigHash    string           `json:"config-hash"`
+	Regions       []string         `json:"regions"`
+	ResourceTypes types.Collection `json:"resource-types"`
+	Items         []CheckpointItem `json:"items"`
+}
+
+type CheckpointItem struct {
+	PlanItem
+	State  ItemState `json:"state"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+func NewCheckpoint(accountID, configHash string, regions []string, resourceTypes types.Collection, queue Queue) *Checkpoint {
+	checkpoint := &Checkpoint{
+		AccountID:     accountID,
+		ConfigHash:    configHash,
+		Regions:       regions,
+		ResourceTypes: resourceTypes,
+		Items:         []CheckpointItem{},
+	}
+
+	for _, item := range queue {
+		checkpoint.Items = append(checkpoint.Items, CheckpointItem{
+			PlanItem: NewPlanItem(item),
+			State:    item.State,
+			Reason:   item.Reason,
+		})
+	}
+
+	return checkpoint
+}
+
+func LoadCheckpoint(path string) (*Checkpoint, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	checkpoint := new(Checkpoint)
+	err = json.Unmarshal(raw, checkpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse checkpoint file %s: %w", path, err)
+	}
+
+	return checkpoint, nil
+}
+
+// Write replaces the checkpoint file atomically, so an interruption while
+// writing does not leave a broken file behind.
+func (c *Checkpoint) Write(path string) error {
+	raw, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	tmp := path + ".tmp"
+	err = os.WriteFile(tmp, append(raw, '\n'), 0600)
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp, path)
+}
+
+// Validate makes sure that the checkpoint is resumed on the same account and
+// with the same config it was created with.
+func (c *Checkpoint) Validate(accountID, configHash string) error {
+	if c.AccountID != accountID {
+		return fmt.Errorf("The checkpoint was created for the account with the ID %s, "+
+			"but you are trying to resume it on %s. Aborting.", c.AccountID, accountID)
+	}
+
+	if c.ConfigHash != configHash {
+		return fmt.Errorf("The config file changed since the checkpoint was created. " +
+			"Start a new run instead. Aborting.")
+	}
+
+	return nil
+}
+
+// IsDone returns true, if the resource type was already scanned in the region
+// and none of its resources are left to be removed.
+func (c *Checkpoint) IsDone(region, resourceType string) bool {
+	if !types.Collection(c.Regions).Contains(region) || !c.ResourceTypes.Contains(resourceType) {
+		return false
+	}
+
+	for _, item := range c.Items {
+		if item.Region != region || item.Type != resourceType {
+			continue
+		}
+
+		switch item.State {
+		case ItemStateFiltered, ItemStateFinished:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
+// Restore applies the state of a previous run to a freshly scanned item.
+// Removals which were already triggered are not requested again, but waited
+// for instead.
+func (c *Checkpoint) Restore(item *Item) {
+	for _, ci := range c.Items {
+		if !ci.Matches(item) {
+			continue
+		}
+
+		switch ci.State {
+		case ItemStatePending, ItemStateWaiting:
+			item.State = ItemStateWaiting
+			item.Reason = ""
+		case ItemStateFailed:
+			item.State = ItemStateFailed
+			item.Reason = ci.Reason
+		}
+
+		return
+	}
+}
@@ -0,0 +1,77 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+)
+
+func TestCheckpointResume(t *testing.T) {
+	region := &Region{Name: "eu-west-1"}
+
+	queue := Queue{
+		{Region: region, Type: "EC2Instance", State: ItemStateWaiting, Resource: &testResource{id: "i-1"}},
+		{Region: region, Type: "EC2Instance", State: ItemStateFailed, Reason: "boom", Resource: &testResource{id: "i-2"}},
+		{Region: region, Type: "IAMRole", State: ItemStateFinished, Resource: &testResource{id: "admin"}},
+		{Region: region, Type: "IAMRole", State: ItemStateFiltered, Resource: &testResource{id: "keep"}},
+	}
+
+	path := filepath.Join(t.TempDir(), "checkpoint.json")
+	err := NewCheckpoint("1234567890", "abc", []string{"eu-west-1"},
+		types.Collection{"EC2Instance", "IAMRole", "S3Bucket"}, queue).Write(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkpoint, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkpoint.Validate("1234567890", "abc"); err != nil {
+		t.Fatalf("Unexpected validation error: %v", err)
+	}
+
+	if err := checkpoint.Validate("1234567890", "def"); err == nil {
+		t.Fatalf("Expected error for different config.")
+	}
+
+	done := []struct {
+		region, resourceType string
+		want                 bool
+	}{
+		{"eu-west-1", "EC2Instance", false},
+		{"eu-west-1", "IAMRole", true},
+		{"eu-west-1", "S3Bucket", true},
+		{"eu-west-1", "SQSQueue", false},
+		{"us-east-1", "IAMRole", false},
+	}
+
+	for _, tc := range done {
+		if have := checkpoint.IsDone(tc.region, tc.resourceType); have != tc.want {
+			t.Errorf("Wrong result for IsDone(%s, %s). Want: %t. Have: %t",
+				tc.region, tc.resourceType, tc.want, have)
+		}
+	}
+
+	restore := []struct {
+		id     string
+		state  ItemState
+		reason string
+	}{
+		{"i-1", ItemStateWaiting, ""},
+		{"i-2", ItemStateFailed, "boom"},
+		{"i-3", ItemStateNew, ""},
+	}
+
+	for _, tc := range restore {
+		item := &Item{Region: region, Type: "EC2Instance", State: ItemStateNew, Resource: &testResource{id: tc.id}}
+		checkpoint.Restore(item)
+
+		if item.State != tc.state || item.Reason != tc.reason {
+			t.Errorf("Wrong restored state for %s. Want: %s (%q). Have: %s (%q)",
+				tc.id, tc.state, tc.reason, item.State, item.Reason)
+		}
+	}
+}
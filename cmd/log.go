@@ -1,12 +1,42 @@
 package cmd
 
 import (
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"sort"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/fatih/color"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
 	"github.com/rebuy-de/aws-nuke/v2/resources"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	OutputFormatText = "text"
+	OutputFormatJSON = "json"
+)
+
+var (
+	// OutputFormat defines whether items and summaries are printed as
+	// colored text or as one JSON event per line.
+	OutputFormat = OutputFormatText
+
+	// Console receives all human readable messages, like prompts and
+	// summaries. In JSON mode they are moved to stderr, so stdout only
+	// contains events.
+	Console io.Writer = os.Stdout
+
+	// LogAccounts prefixes every resource line with its account ID. It is
+	// enabled when multiple accounts are nuked, whose output may interleave.
+	LogAccounts = false
+
+	eventWriter io.Writer = os.Stdout
+	eventLock   sync.Mutex
 )
 
 var (
@@ -18,12 +48,69 @@ var (
 )
 
 var (
+	ColorAccount            = *color.New(color.Bold)
 	ColorRegion             = *color.New(color.Bold)
 	ColorResourceType       = *color.New()
 	ColorResourceID         = *color.New(color.Bold)
 	ColorResourceProperties = *color.New(color.Italic)
 )
 
+// SetOutputFormat switches the output between text and JSON mode.
+func SetOutputFormat(format string) {
+	OutputFormat = format
+	Console = os.Stdout
+	if format == OutputFormatJSON {
+		Console = os.Stderr
+	}
+}
+
+// Event is a single line of the JSON output. It either describes the state
+// of a resource or summarizes a phase of the run.
+type Event struct {
+	Time  time.Time `json:"time"`
+	Event string    `json:"event"`
+
+	Account string `json:"account,omitempty"`
+
+	Region       string           `json:"region,omitempty"`
+	ResourceType string           `json:"resource-type,omitempty"`
+	ID           string           `json:"id,omitempty"`
+	Properties   types.Properties `json:"properties,omitempty"`
+	State        string           `json:"state,omitempty"`
+	Reason       string           `json:"reason,omitempty"`
+	Snapshot     string           `json:"snapshot,omitempty"`
+	Filter       string           `json:"filter,omitempty"`
+
+	Service string         `json:"service,omitempty"`
+	Counts  map[string]int `json:"counts,omitempty"`
+}
+
+func LogEvent(event Event) {
+	event.Time = time.Now().UTC()
+
+	eventLock.Lock()
+	defer eventLock.Unlock()
+
+	err := json.NewEncoder(eventWriter).Encode(event)
+	if err != nil {
+		log.Errorf("failed to write event: %v", err)
+	}
+}
+
+// LogSummary prints the formatted message in text mode and an event with the
+// given counts in JSON mode.
+func LogSummary(event string, counts map[string]int, format string, a ...interface{}) {
+	if OutputFormat == OutputFormatJSON {
+		LogEvent(Event{
+			Event:  event,
+			Counts: counts,
+		})
+		return
+	}
+
+	fmt.Printf(format, a...)
+}
+
 // Format the resource properties in sorted order ready for printing.
 // This ensures that multiple runs of aws-nuke produce stable output so
 // that they can be compared with each other.
@@ -41,6 +128,10 @@ func Sorted(m map[string]string) string {
 }
 
 func Log(region *Region, resourceType string, r resources.Resource, c color.Color, msg string) {
+	if LogAccounts && region.Account != "" {
+		ColorAccount.Printf("%s", region.Account)
+		fmt.Printf(" - ")
+	}
 	ColorRegion.Printf("%s", region.Name)
 	fmt.Printf(" - ")
 	ColorResourceType.Print(resourceType)
@@ -0,0 +1,118 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"os"
+	"testing"
+
+	"github.com/fatih/color"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+)
+
+type testPropertyResource struct {
+	properties types.Properties
+}
+
+func (r *testPropertyResource) Remove() error {
+	return nil
+}
+
+func (r *testPropertyResource) Properties() types.Properties {
+	return r.properties
+}
+
+type testLegacyPropertyResource struct {
+	testPropertyResource
+	id string
+}
+
+func (r *testLegacyPropertyResource) String() string {
+	return r.id
+}
+
+func TestItemPrintJSON(t *testing.T) {
+	buf := new(bytes.Buffer)
+	eventWriter = buf
+	OutputFormat = OutputFormatJSON
+	defer func() {
+		SetOutputFormat(OutputFormatText)
+		eventWriter = os.Stdout
+	}()
+
+	item := &Item{
+		Region: &Region{Name: "eu-west-1", Account: "123456789012"},
+		Type:   "EC2Instance",
+		State:  ItemStateFiltered,
+		Reason: "filtered by config",
+		Resource: &testLegacyPropertyResource{
+			testPropertyResource: testPropertyResource{
+				properties: types.NewProperties().Set("tag:team", "platform"),
+			},
+			id: "i-123",
+		},
+	}
+	item.Print()
+
+	LogSummary("scan-complete", map[string]int{"total": 1, "filtered": 1}, "ignored")
+
+	dec := json.NewDecoder(buf)
+
+	var resource Event
+	if err := dec.Decode(&resource); err != nil {
+		t.Fatal(err)
+	}
+
+	if resource.Event != "resource" || resource.Account != "123456789012" || resource.Region != "eu-west-1" ||
+		resource.ResourceType != "EC2Instance" || resource.ID != "i-123" ||
+		resource.State != "filtered" || resource.Reason != "filtered by config" {
+		t.Fatalf("Wrong resource event: %#v", resource)
+	}
+
+	if resource.Properties.Get("tag:team") != "platform" {
+		t.Fatalf("Wrong properties in event: %v", resource.Properties)
+	}
+
+	if resource.Time.IsZero() {
+		t.Fatalf("Event has no timestamp.")
+	}
+
+	var summary Event
+	if err := dec.Decode(&summary); err != nil {
+		t.Fatal(err)
+	}
+
+	if summary.Event != "scan-complete" || summary.Counts["total"] != 1 || summary.Counts["filtered"] != 1 {
+		t.Fatalf("Wrong summary event: %#v", summary)
+	}
+}
+
+func TestLogAccountPrefix(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stdout, colorOutput := os.Stdout, color.Output
+	os.Stdout, color.Output = w, w
+	LogAccounts = true
+	defer func() {
+		os.Stdout, color.Output = stdout, colorOutput
+		LogAccounts = false
+	}()
+
+	region := &Region{Name: "eu-west-1", Account: "123456789012"}
+	Log(region, "EC2Instance", &testResource{id: "i-123"}, ReasonSuccess, "removed")
+	w.Close()
+
+	out, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := "123456789012 - eu-west-1 - EC2Instance - i-123 - removed\n"
+	if string(out) != want {
+		t.Errorf("Wrong log line.\nWant: %q\nHave: %q", want, string(out))
+	}
+}
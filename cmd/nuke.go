@@ -1,7 +1,10 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
@@ -16,72 +19,139 @@ type Nuke struct {
 	Account    awsutil.Account
 	Config     *config.Nuke
 
+	// Plan restricts the removal to the resources of a previous dry run.
+	Plan *Plan
+
+	// Checkpoint holds the state of an interrupted run, which is resumed.
+	Checkpoint *Checkpoint
+
+	// Organization is used to look up the account in AWS Organizations, if
+	// the config limits aws-nuke to certain organizational units or account
+	// tags. These are the credentials of the management account or of a
+	// delegated administrator.
+	Organization *awsutil.Credentials
+
 	ResourceTypes types.Collection
 
+	// Regions are the scanned regions, with the keywords and excludes of
+	// the config resolved.
+	Regions []string
+
+	// RunID identifies the final snapshots of this run.
+	RunID string
+
 	items Queue
+
+	// matchedFilters contains the origins of all filters, which matched at
+	// least one resource.
+	matchedFilters map[string]bool
 }
 
 func NewNuke(params NukeParameters, account awsutil.Account) *Nuke {
 	n := Nuke{
 		Parameters: params,
 		Account:    account,
+		RunID:      NewRunID(),
 	}
 
 	return &n
 }
 
-func (n *Nuke) Run() error {
-	var err error
-
-	fmt.Println("Running trek10inc/aws-nuke")
+func (n *Nuke) Run(ctx context.Context) (err error) {
+	fmt.Fprintln(Console, "Running trek10inc/aws-nuke")
 	if n.Parameters.ForceSleep < 3 && n.Parameters.NoDryRun {
 		return fmt.Errorf("Value for --force-sleep cannot be less than 3 seconds if --no-dry-run is set. This is for your own protection.")
 	}
 	forceSleep := time.Duration(n.Parameters.ForceSleep) * time.Second
 
-	fmt.Printf("aws-nuke version %s - %s - %s\n\n", BuildVersion, BuildDate, BuildHash)
+	fmt.Fprintf(Console, "aws-nuke version %s - %s - %s\n\n", BuildVersion, BuildDate, BuildHash)
 
-	err = n.Config.ValidateAccount(n.Account.ID(), n.Account.Aliases())
+	err = n.ValidateAccount()
 	if err != nil {
 		return err
 	}
 
-	fmt.Printf("Do you really want to nuke the account with "+
+	if n.Plan != nil {
+		err = n.Plan.Validate(n.Account.ID(), n.Config.Hash())
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.Checkpoint != nil {
+		err = n.Checkpoint.Validate(n.Account.ID(), n.Config.Hash())
+		if err != nil {
+			return err
+		}
+	}
+
+	fmt.Fprintf(Console, "Do you really want to nuke the account with "+
 		"the ID %s and the alias '%s'?\n", n.Account.ID(), n.Account.Alias())
 	if n.Parameters.Force {
-		fmt.Printf("Waiting %v before continuing.\n", forceSleep)
-		time.Sleep(forceSleep)
+		fmt.Fprintf(Console, "Waiting %v before continuing.\n", forceSleep)
+		err = Sleep(ctx, forceSleep)
+		if err != nil {
+			return fmt.Errorf("aborted")
+		}
 	} else {
-		fmt.Printf("Do you want to continue? Enter account alias to continue.\n")
-		err = Prompt(n.Account.Alias())
+		fmt.Fprintf(Console, "Do you want to continue? Enter account alias to continue.\n")
+		err = Prompt(ctx, n.Account.Alias())
 		if err != nil {
 			return err
 		}
 	}
 
-	err = n.Scan()
+	defer n.PrintThrottling()
+	defer n.PrintUnusedFilters()
+
+	startedAt := time.Now()
+	defer func() {
+		auditErr := n.WriteAudit(startedAt, err)
+		if auditErr == nil {
+			return
+		}
+
+		if err != nil {
+			logrus.Errorf("Failed to write audit manifest: %v", auditErr)
+			return
+		}
+
+		err = auditErr
+	}()
+
+	err = n.Scan(ctx)
 	if err != nil {
 		return err
 	}
 
-	if n.items.Count(ItemStateNew) == 0 {
-		fmt.Println("No resource to delete.")
+	if n.Parameters.PlanOut != "" {
+		err = n.WritePlan(n.Parameters.PlanOut)
+		if err != nil {
+			return err
+		}
+	}
+
+	if n.items.Count(ItemStateNew, ItemStatePending, ItemStateWaiting, ItemStateFailed) == 0 {
+		fmt.Fprintln(Console, "No resource to delete.")
 		return nil
 	}
 
 	if !n.Parameters.NoDryRun {
-		fmt.Println("The above resources would be deleted with the supplied configuration. Provide --no-dry-run to actually destroy resources.")
+		fmt.Fprintln(Console, "The above resources would be deleted with the supplied configuration. Provide --no-dry-run to actually destroy resources.")
 		return nil
 	}
 
-	fmt.Printf("Do you really want to nuke these resources on the account with "+
+	fmt.Fprintf(Console, "Do you really want to nuke these resources on the account with "+
 		"the ID %s and the alias '%s'?\n", n.Account.ID(), n.Account.Alias())
 	if n.Parameters.Force {
-		fmt.Printf("Waiting %v before continuing.\n", forceSleep)
-		time.Sleep(forceSleep)
+		fmt.Fprintf(Console, "Waiting %v before continuing.\n", forceSleep)
+		err = Sleep(ctx, forceSleep)
+		if err != nil {
+			return fmt.Errorf("aborted")
+		}
 	} else {
-		fmt.Printf("Do you want to continue? Enter account alias to continue.\n")
-		err = Prompt(n.Account.Alias())
+		fmt.Fprintf(Console, "Do you want to continue? Enter account alias to continue.\n")
+		err = Prompt(ctx, n.Account.Alias())
 		if err != nil {
 			return err
 		}
@@ -90,13 +160,28 @@ func (n *Nuke) Run() error {
 	failCount := 0
 	waitingCount := 0
 
+	err = n.WriteCheckpoint()
+	if err != nil {
+		return err
+	}
+
 	for {
-		n.HandleQueue()
+		n.HandleQueue(ctx)
+
+		err = n.WriteCheckpoint()
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			n.PrintInterrupted()
+			return fmt.Errorf("interrupted")
+		}
 
 		if n.items.Count(ItemStatePending, ItemStateWaiting, ItemStateNew) == 0 && n.items.Count(ItemStateFailed) > 0 {
 			if failCount >= 2 {
 				logrus.Errorf("There are resources in failed state, but none are ready for deletion, anymore.")
-				fmt.Println()
+				fmt.Fprintln(Console)
 
 				for _, item := range n.items {
 					if item.State != ItemStateFailed {
@@ -114,7 +199,7 @@ func (n *Nuke) Run() error {
 		} else {
 			failCount = 0
 		}
-		if n.Parameters.MaxWaitRetries != 0 && n.items.Count(ItemStateWaiting, ItemStatePending) > 0 && n.items.Count(ItemStateNew) == 0 {
+		if n.Parameters.MaxWaitRetries != 0 && n.items.Count(ItemStateWaiting, ItemStatePending) > 0 && n.items.Count(ItemStateNew) == len(n.items.Blocked()) {
 			if waitingCount >= n.Parameters.MaxWaitRetries {
 				return fmt.Errorf("Max wait retries of %d exceeded.\n\n", n.Parameters.MaxWaitRetries)
 			}
@@ -126,152 +211,583 @@ func (n *Nuke) Run() error {
 			break
 		}
 
-		time.Sleep(5 * time.Second)
+		err = Sleep(ctx, 5*time.Second)
+		if err != nil {
+			n.PrintInterrupted()
+			return fmt.Errorf("interrupted")
+		}
 	}
 
-	fmt.Printf("Nuke complete: %d failed, %d skipped, %d finished.\n\n",
-		n.items.Count(ItemStateFailed), n.items.Count(ItemStateFiltered), n.items.Count(ItemStateFinished))
+	n.PrintComplete()
 
 	return nil
 }
 
-func (n *Nuke) Scan() error {
+func (n *Nuke) PrintComplete() {
+	var (
+		failed   = n.items.Count(ItemStateFailed)
+		skipped  = n.items.Count(ItemStateFiltered)
+		finished = n.items.Count(ItemStateFinished)
+	)
+	LogSummary("nuke-complete",
+		map[string]int{"failed": failed, "skipped": skipped, "finished": finished},
+		"Nuke complete: %d failed, %d skipped, %d finished.\n\n",
+		failed, skipped, finished)
+}
+
+// PrintUnusedFilters lists the filters of the account, which did not match
+// any resource, so stale entries can be removed from the config. It only
+// works in explain mode, since otherwise the filters after the first match
+// are not checked. Runs restricted by a plan or a checkpoint do not see all
+// resources, so they are not reported either.
+func (n *Nuke) PrintUnusedFilters() {
+	if !n.Parameters.Explain || n.items == nil || n.Plan != nil || n.Checkpoint != nil {
+		return
+	}
+
+	filters, err := n.Config.AllFiltersWithOrigin(n.Account.ID())
+	if err != nil {
+		// The same error already aborted the scan.
+		return
+	}
+
+	unused := []config.FilterWithOrigin{}
+	for _, filter := range filters {
+		if !n.matchedFilters[filter.Origin.String()] {
+			unused = append(unused, filter)
+		}
+	}
+
+	if len(unused) == 0 {
+		return
+	}
+
+	if OutputFormat == OutputFormatJSON {
+		for _, filter := range unused {
+			LogEvent(Event{
+				Event:        "unused-filter",
+				Account:      n.Account.ID(),
+				Region:       filter.Origin.Region,
+				ResourceType: filter.Origin.ResourceType,
+				Filter:       filter.Origin.String(),
+			})
+		}
+		return
+	}
+
+	fmt.Fprintf(Console, "These filters did not match any resource:\n")
+	for _, filter := range unused {
+		fmt.Fprintf(Console, "  %s\n", filter.Describe())
+	}
+	fmt.Fprintln(Console)
+}
+
+// PrintThrottling prints the services, whose requests were throttled or
+// retried during the run.
+func (n *Nuke) PrintThrottling() {
+	for _, stats := range n.Account.Throttler.Stats() {
+		if stats.Throttled == 0 && stats.Retried == 0 {
+			continue
+		}
+
+		counts := map[string]int{
+			"requests":  stats.Requests,
+			"throttled": stats.Throttled,
+			"retried":   stats.Retried,
+		}
+
+		if OutputFormat == OutputFormatJSON {
+			LogEvent(Event{
+				Event:   "throttling",
+				Account: n.Account.ID(),
+				Service: stats.Service,
+				Counts:  counts,
+			})
+			continue
+		}
+
+		fmt.Fprintf(Console, "AWS throttling for %s: %d requests, %d throttled, %d retried.\n",
+			stats.Service, stats.Requests, stats.Throttled, stats.Retried)
+	}
+}
+
+// PrintInterrupted prints the usual final summary and all resources which
+// were not removed, because the run got interrupted.
+func (n *Nuke) PrintInterrupted() {
+	fmt.Fprintln(Console)
+	n.PrintComplete()
+
+	if n.items.Count(ItemStateNew, ItemStatePending, ItemStateWaiting, ItemStateFailed) == 0 {
+		return
+	}
+
+	fmt.Fprintln(Console, "The run was interrupted. These resources are still pending:")
+	for _, item := range n.items {
+		switch item.State {
+		case ItemStateNew, ItemStatePending, ItemStateWaiting, ItemStateFailed:
+			item.Print()
+		}
+	}
+	fmt.Fprintln(Console)
+}
+
+// ResolveRegions expands 'all' and 'enabled' in the configured regions with
+// the regions of the account. They are looked up for every account, since
+// each account opts into regions on its own.
+func (n *Nuke) ResolveRegions() error {
+	var discovered []config.RegionStatus
+	if n.Config.HasRegionKeywords() {
+		var err error
+		discovered, err = n.Account.DescribeRegions()
+		if err != nil {
+			return err
+		}
+	}
+
+	regions, skipped := n.Config.ResolveRegions(discovered)
+	for _, region := range skipped {
+		logrus.Warnf("Skipping region %s, since it is not enabled for the account %s.",
+			region, n.Account.ID())
+	}
+
+	if len(regions) == 0 && len(n.Config.Regions) > 0 {
+		return fmt.Errorf("all regions of the config are excluded")
+	}
+
+	logrus.Debugf("Scanning the regions %s", strings.Join(regions, ", "))
+	n.Regions = regions
+
+	return nil
+}
+
+// RegionResourceTypes returns the resource types, which are scanned in the
+// region. These are the ones of the flags, the config and the account,
+// narrowed down by the ones of the region.
+func (n *Nuke) RegionResourceTypes(region string) types.Collection {
 	accountConfig := n.Config.Accounts[n.Account.ID()]
 
+	targets := []types.Collection{
+		n.Parameters.Targets,
+		n.Config.ResourceTypes.Targets,
+		accountConfig.ResourceTypes.Targets,
+	}
+	excludes := []types.Collection{
+		n.Parameters.Excludes,
+		n.Config.ResourceTypes.Excludes,
+		accountConfig.ResourceTypes.Excludes,
+	}
+	cloudControl := []types.Collection{
+		n.Parameters.CloudControl,
+		n.Config.ResourceTypes.CloudControl,
+		accountConfig.ResourceTypes.CloudControl,
+	}
+
+	for _, regionConfig := range n.Config.RegionResourceTypes(n.Account.ID(), region) {
+		targets = append(targets, regionConfig.Targets)
+		excludes = append(excludes, regionConfig.Excludes)
+		cloudControl = append(cloudControl, regionConfig.CloudControl)
+	}
+
 	resourceTypes := ResolveResourceTypes(
 		resources.GetListerNames(),
 		resources.GetCloudControlMapping(),
-		[]types.Collection{
-			n.Parameters.Targets,
-			n.Config.ResourceTypes.Targets,
-			accountConfig.ResourceTypes.Targets,
-		},
-		[]types.Collection{
-			n.Parameters.Excludes,
-			n.Config.ResourceTypes.Excludes,
-			accountConfig.ResourceTypes.Excludes,
-		},
-		[]types.Collection{
-			n.Parameters.CloudControl,
-			n.Config.ResourceTypes.CloudControl,
-			accountConfig.ResourceTypes.CloudControl,
-		},
+		targets, excludes, cloudControl,
 	)
 
-	queue := make(Queue, 0)
+	if n.Plan != nil {
+		resourceTypes = resourceTypes.Intersect(n.Plan.ResourceTypes())
+	}
 
-	for _, regionName := range n.Config.Regions {
-		region := NewRegion(regionName, n.Account.ResourceTypeToServiceType, n.Account.NewSession)
+	return resourceTypes
+}
 
-		items := Scan(region, resourceTypes)
-		for item := range items {
-			ffGetter, ok := item.Resource.(resources.FeatureFlagGetter)
-			if ok {
-				ffGetter.FeatureFlags(n.Config.FeatureFlags)
-			}
+func (n *Nuke) Scan(ctx context.Context) error {
+	err := n.ResolveRegions()
+	if err != nil {
+		return err
+	}
 
-			queue = append(queue, item)
-			err := n.Filter(item)
-			if err != nil {
-				return err
-			}
+	n.ResourceTypes = types.Collection{}
 
-			if item.State != ItemStateFiltered || !n.Parameters.Quiet {
-				item.Print()
+	targets := []ScanTarget{}
+	for _, regionName := range n.Regions {
+		if n.Plan != nil && !n.Plan.HasRegion(regionName) {
+			continue
+		}
+
+		region := NewRegion(regionName, n.Account.ResourceTypeToServiceType, n.Account.NewSession)
+		region.Account = n.Account.ID()
+
+		resourceTypes := n.RegionResourceTypes(regionName)
+		n.ResourceTypes = n.ResourceTypes.Union(resourceTypes)
+
+		regionTypes := resourceTypes
+		if n.Checkpoint != nil {
+			regionTypes = types.Collection{}
+			for _, resourceType := range resourceTypes {
+				if n.Checkpoint.IsDone(regionName, resourceType) {
+					logrus.Debugf("%s - %s - skipping, since it is already done according to the checkpoint",
+						regionName, resourceType)
+					continue
+				}
+				regionTypes = append(regionTypes, resourceType)
 			}
 		}
+
+		targets = append(targets, ScanTarget{Region: region, ResourceTypes: regionTypes})
+	}
+
+	queue := make(Queue, 0)
+
+	items := Scan(ctx, targets, n.Parameters.ScanParallelism, n.Parameters.ListTimeout)
+	for item := range items {
+		ffGetter, ok := item.Resource.(resources.FeatureFlagGetter)
+		if ok {
+			ffGetter.FeatureFlags(n.Config.FeatureFlags)
+		}
+
+		queue = append(queue, item)
+		err := n.Filter(item)
+		if err != nil {
+			return err
+		}
+
+		if n.Checkpoint != nil && item.State == ItemStateNew {
+			n.Checkpoint.Restore(item)
+		}
+
+		if item.State != ItemStateFiltered || !n.Parameters.Quiet {
+			item.Print()
+		}
 	}
 
-	fmt.Printf("Scan complete: %d total, %d nukeable, %d filtered.\n\n",
-		queue.CountTotal(), queue.Count(ItemStateNew), queue.Count(ItemStateFiltered))
+	var (
+		total    = queue.CountTotal()
+		nukeable = queue.Count(ItemStateNew, ItemStateWaiting, ItemStateFailed)
+		filtered = queue.Count(ItemStateFiltered)
+	)
+	LogSummary("scan-complete",
+		map[string]int{"total": total, "nukeable": nukeable, "filtered": filtered},
+		"Scan complete: %d total, %d nukeable, %d filtered.\n\n",
+		total, nukeable, filtered)
 
 	n.items = queue
 
+	if ctx.Err() != nil {
+		fmt.Fprintln(Console, "The scan was interrupted. Nothing was removed.")
+		return fmt.Errorf("interrupted")
+	}
+
 	return nil
 }
 
+// OlderThan returns the minimum age of resources, which are removed. The flag
+// takes precedence over the config.
+func (n *Nuke) OlderThan() time.Duration {
+	if n.Parameters.OlderThan > 0 {
+		return n.Parameters.OlderThan
+	}
+
+	return n.Config.OlderThan
+}
+
 func (n *Nuke) Filter(item *Item) error {
+	if n.Plan != nil && !n.Plan.Contains(item) {
+		item.State = ItemStateFiltered
+		item.Reason = "not in plan"
+		return nil
+	}
 
-	checker, ok := item.Resource.(resources.Filter)
-	if ok {
-		err := checker.Filter()
-		if err != nil {
-			item.State = ItemStateFiltered
-			item.Reason = err.Error()
+	if reason := n.skipReason(item); reason != "" {
+		item.State = ItemStateFiltered
+		item.Reason = reason
 
-			// Not returning the error, since it could be because of a failed
-			// request to the API. We do not want to block the whole nuking,
-			// because of an issue on AWS side.
+		// In explain mode the config filters are still checked, so the ones
+		// matching skipped resources are not reported as unused. The reason
+		// stays the one from above.
+		if !n.Parameters.Explain {
 			return nil
 		}
 	}
 
-	accountFilters, err := n.Config.Filters(n.Account.ID())
+	accountFilters, err := n.Config.FiltersWithOrigin(n.Account.ID(), item.Region.Name)
 	if err != nil {
 		return err
 	}
 
-	itemFilters, ok := accountFilters[item.Type]
-	if !ok {
-		return nil
+	itemFilters := append([]config.FilterWithOrigin{}, accountFilters[item.Type]...)
+
+	// Global filters apply to every resource type, but only make sense for
+	// those which support the properties used by the filter.
+	for _, filter := range accountFilters[config.GlobalFiltersKey] {
+		if item.SupportsProperties(filter.Properties()...) {
+			itemFilters = append(itemFilters, filter)
+		}
 	}
 
 	for _, filter := range itemFilters {
-		prop, err := item.GetProperty(filter.Property)
+		match, err := n.MatchFilter(item, filter.Filter)
 		if err != nil {
-			logrus.Warnf(err.Error())
+			return err
+		}
+
+		if !match {
 			continue
 		}
-		match, err := filter.Match(prop, n.Config)
+
+		if n.matchedFilters == nil {
+			n.matchedFilters = map[string]bool{}
+		}
+		n.matchedFilters[filter.Origin.String()] = true
+
+		// In explain mode all filters are checked, to find the unused
+		// ones, but the first match is the one which is reported.
+		if item.State == ItemStateFiltered {
+			continue
+		}
+
+		filter := filter
+		item.State = ItemStateFiltered
+		item.FilteredBy = &filter
+		item.Reason = "filtered by config"
+		if n.Parameters.Explain || logrus.IsLevelEnabled(logrus.DebugLevel) {
+			item.Reason = "filtered by " + filter.Describe()
+		}
+
+		if !n.Parameters.Explain {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// skipReason returns why the resource is always skipped, regardless of the
+// filters in the config, or an empty string.
+func (n *Nuke) skipReason(item *Item) string {
+	checker, ok := item.Resource.(resources.Filter)
+	if ok {
+		err := checker.Filter()
 		if err != nil {
-			return err
+			// Not returning the error, since it could be because of a failed
+			// request to the API. We do not want to block the whole nuking,
+			// because of an issue on AWS side.
+			return err.Error()
+		}
+	}
+
+	if olderThan := n.OlderThan(); olderThan > 0 {
+		createdAt, ok := item.CreatedAt()
+		if ok && createdAt.After(time.Now().Add(-olderThan)) {
+			return fmt.Sprintf("created less than %s ago", olderThan)
 		}
+	}
+
+	if until, ok := RetainedUntil(item); ok {
+		return fmt.Sprintf("final snapshot retained until %s", until.Format(time.RFC3339))
+	}
+
+	return ""
+}
+
+// MatchFilter checks a single filter from the config against the item.
+// Composite filters are evaluated recursively. A filter on a property which
+// is not supported by the resource does not match.
+func (n *Nuke) MatchFilter(item *Item, filter config.Filter) (bool, error) {
+	var (
+		match bool
+		err   error
+	)
 
-		if IsTrue(filter.Invert) {
+	switch {
+	case filter.All != nil:
+		match = true
+		for _, f := range filter.All {
+			match, err = n.MatchFilter(item, f)
+			if err != nil || !match {
+				break
+			}
+		}
+
+	case filter.Any != nil:
+		for _, f := range filter.Any {
+			match, err = n.MatchFilter(item, f)
+			if err != nil || match {
+				break
+			}
+		}
+
+	case filter.Not != nil:
+		match, err = n.MatchFilter(item, *filter.Not)
+		match = !match
+
+	case filter.Type == config.FilterTypeExists || filter.Type == config.FilterTypeMissing:
+		match, err = item.HasProperty(filter.Property)
+		if err != nil {
+			logrus.Warnf(err.Error())
+			return false, nil
+		}
+		if filter.Type == config.FilterTypeMissing {
 			match = !match
 		}
 
-		if match {
-			item.State = ItemStateFiltered
-			item.Reason = "filtered by config"
-			return nil
+	default:
+		var prop string
+		prop, err = item.GetProperty(filter.Property)
+		if err != nil {
+			logrus.Warnf(err.Error())
+			return false, nil
 		}
+		match, err = filter.Match(prop, n.Config)
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if IsTrue(filter.Invert) {
+		match = !match
+	}
+
+	return match, nil
+}
+
+// ValidateAccount checks whether the account may be nuked at all.
+func (n *Nuke) ValidateAccount() error {
+	err := n.Config.ValidateAccount(n.Account.ID(), n.Account.Aliases())
+	if err != nil {
+		return err
+	}
+
+	if !n.Config.RequiresOrganization() {
+		return nil
+	}
+
+	if n.Organization == nil {
+		return fmt.Errorf("The config limits aws-nuke to organizational units or account tags, " +
+			"but there are no credentials for AWS Organizations. Aborting.")
+	}
+
+	account, err := n.Organization.DescribeOrganizationAccount(n.Account.ID())
+	if err != nil {
+		return fmt.Errorf("failed to verify the account in AWS Organizations: %w", err)
+	}
+
+	return n.Config.ValidateOrganizationAccount(account.ID, account.ParentIDs, account.Tags)
+}
+
+func (n *Nuke) WritePlan(path string) error {
+	plan := NewPlan(n.Account.ID(), n.Config.Hash(), n.items)
+	err := plan.Write(path)
+	if err != nil {
+		return err
 	}
 
+	fmt.Fprintf(Console, "Plan with %d resources written to %s.\n\n", len(plan.Items), path)
 	return nil
 }
 
-func (n *Nuke) HandleQueue() {
+// WriteCheckpoint persists the current state of the queue, if a checkpoint
+// file is configured.
+func (n *Nuke) WriteCheckpoint() error {
+	if n.Parameters.CheckpointPath == "" {
+		return nil
+	}
+
+	checkpoint := NewCheckpoint(n.Account.ID(), n.Config.Hash(), n.Regions, n.ResourceTypes, n.items)
+	return checkpoint.Write(n.Parameters.CheckpointPath)
+}
+
+func (n *Nuke) HandleQueue(ctx context.Context) {
 	listCache := make(map[string]map[string][]resources.Resource)
 
+	blocked := n.items.Blocked()
+	if len(blocked) > 0 && len(blocked) == n.items.Count(ItemStateNew) &&
+		n.items.Count(ItemStatePending, ItemStateWaiting) == 0 {
+		// Nothing would make progress, which means that the dependencies
+		// are cyclic. Try to remove everything and rely on retries instead.
+		logrus.Warnf("All %d remaining resources are blocked by each other; ignoring dependencies.", len(blocked))
+		blocked = nil
+	}
+
+	// Remember the states from the beginning of this pass, since the
+	// removals below already change them.
+	states := make(map[*Item]ItemState, len(n.items))
+	removals := []*Item{}
 	for _, item := range n.items {
+		states[item] = item.State
+
 		switch item.State {
 		case ItemStateNew:
-			n.HandleRemove(item)
+			if dependency, ok := blocked[item]; ok {
+				logrus.Debugf("%s - %s - waiting for %s resources to be removed first",
+					item.Region.Name, item.Type, dependency)
+				continue
+			}
+			removals = append(removals, item)
+		case ItemStateFailed:
+			removals = append(removals, item)
+		}
+	}
+
+	n.RemoveAll(ctx, removals)
+
+	for _, item := range n.items {
+		if ctx.Err() != nil {
+			// Do not wait for anything after an interrupt, but still show
+			// the removals which were triggered before.
+			if item.State != states[item] {
+				item.Print()
+			}
+			continue
+		}
+
+		switch states[item] {
+		case ItemStateNew:
+			if _, ok := blocked[item]; ok {
+				continue
+			}
 			item.Print()
 		case ItemStateFailed:
-			n.HandleRemove(item)
-			n.HandleWait(item, listCache)
+			n.HandleWait(ctx, item, listCache)
 			item.Print()
 		case ItemStatePending:
-			n.HandleWait(item, listCache)
+			n.HandleWait(ctx, item, listCache)
 			item.State = ItemStateWaiting
 			item.Print()
 		case ItemStateWaiting:
-			n.HandleWait(item, listCache)
+			n.HandleWait(ctx, item, listCache)
 			item.Print()
 		}
 
 	}
 
-	fmt.Println()
-	fmt.Printf("Removal requested: %d waiting, %d failed, %d skipped, %d finished\n\n",
-		n.items.Count(ItemStateWaiting, ItemStatePending), n.items.Count(ItemStateFailed),
-		n.items.Count(ItemStateFiltered), n.items.Count(ItemStateFinished))
+	var (
+		waiting  = n.items.Count(ItemStateWaiting, ItemStatePending)
+		failed   = n.items.Count(ItemStateFailed)
+		skipped  = n.items.Count(ItemStateFiltered)
+		finished = n.items.Count(ItemStateFinished)
+	)
+	LogSummary("removal-requested",
+		map[string]int{"waiting": waiting, "failed": failed, "skipped": skipped, "finished": finished},
+		"\nRemoval requested: %d waiting, %d failed, %d skipped, %d finished\n\n",
+		waiting, failed, skipped, finished)
 }
 
-func (n *Nuke) HandleRemove(item *Item) {
-	err := item.Resource.Remove()
+// HandleRemove removes the resource of the item. An interrupt does not abort
+// a running removal, since this would leave resources half removed. Only the
+// --remove-timeout does.
+func (n *Nuke) HandleRemove(ctx context.Context, item *Item) {
+	ctx, cancel := WithTimeout(context.WithoutCancel(ctx), n.Parameters.RemoveTimeout)
+	defer cancel()
+
+	err := n.TakeFinalSnapshot(ctx, item)
+	if err == nil {
+		err = resources.RemoveWithContext(ctx, item.Resource)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		err = fmt.Errorf("removal timed out after %s", n.Parameters.RemoveTimeout)
+	}
 	if err != nil {
 		item.State = ItemStateFailed
 		item.Reason = err.Error()
@@ -282,7 +798,7 @@ func (n *Nuke) HandleRemove(item *Item) {
 	item.Reason = ""
 }
 
-func (n *Nuke) HandleWait(item *Item, cache map[string]map[string][]resources.Resource) {
+func (n *Nuke) HandleWait(ctx context.Context, item *Item, cache map[string]map[string][]resources.Resource) {
 	var err error
 	region := item.Region.Name
 	_, ok := cache[region]
@@ -291,7 +807,13 @@ func (n *Nuke) HandleWait(item *Item, cache map[string]map[string][]resources.Re
 	}
 	left, ok := cache[region][item.Type]
 	if !ok {
-		left, err = item.List()
+		listCtx, cancel := WithTimeout(ctx, n.Parameters.ListTimeout)
+		left, err = item.List(listCtx)
+		cancel()
+		if err != nil && ctx.Err() != nil {
+			// Interrupted. Keep the state, since nothing is known.
+			return
+		}
 		if err != nil {
 			item.State = ItemStateFailed
 			item.Reason = err.Error()
@@ -316,4 +838,5 @@ func (n *Nuke) HandleWait(item *Item, cache map[string]map[string][]resources.Re
 
 	item.State = ItemStateFinished
 	item.Reason = ""
+	item.FinishedAt = time.Now()
 }
@@ -0,0 +1,399 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+	yaml "gopkg.in/yaml.v3"
+)
+
+func TestMatchFilter(t *testing.T) {
+	item := &Item{
+		Region: &Region{Name: "eu-west-1"},
+		Type:   "EC2Instance",
+		Resource: &testPropertyResource{
+			properties: types.NewProperties().
+				Set("tag:team", "platform").
+				Set("tag:empty", "").
+				Set("Name", "foo"),
+		},
+	}
+
+	cases := []struct {
+		name  string
+		yaml  string
+		match bool
+	}{
+		{
+			name:  "Exists",
+			yaml:  `{"property":"tag:empty","type":"exists"}`,
+			match: true,
+		},
+		{
+			name:  "ExistsNot",
+			yaml:  `{"property":"tag:owner","type":"exists"}`,
+			match: false,
+		},
+		{
+			name:  "Missing",
+			yaml:  `{"property":"tag:owner","type":"missing"}`,
+			match: true,
+		},
+		{
+			name:  "AllMatch",
+			yaml:  `{"all":[{"property":"tag:team","value":"platform"},{"property":"Name","type":"glob","value":"f*"}]}`,
+			match: true,
+		},
+		{
+			name:  "AllMismatch",
+			yaml:  `{"all":[{"property":"tag:team","value":"platform"},{"property":"Name","value":"bar"}]}`,
+			match: false,
+		},
+		{
+			name:  "AnyMatch",
+			yaml:  `{"any":[{"property":"Name","value":"bar"},{"property":"tag:team","value":"platform"}]}`,
+			match: true,
+		},
+		{
+			name:  "AnyMismatch",
+			yaml:  `{"any":[{"property":"Name","value":"bar"},{"property":"tag:team","value":"data"}]}`,
+			match: false,
+		},
+		{
+			name:  "Not",
+			yaml:  `{"not":{"property":"tag:team","value":"data"}}`,
+			match: true,
+		},
+		{
+			name:  "Nested",
+			yaml:  `{"all":[{"property":"tag:team","value":"platform"},{"not":{"any":[{"property":"tag:owner","type":"exists"},{"property":"Name","value":"bar"}]}}]}`,
+			match: true,
+		},
+		{
+			name:  "InvertedComposite",
+			yaml:  `{"all":[{"property":"tag:team","value":"platform"}],"invert":"true"}`,
+			match: false,
+		},
+		{
+			name:  "UnsupportedLegacyID",
+			yaml:  `{"not":{"value":"foo"}}`,
+			match: true,
+		},
+	}
+
+	n := &Nuke{Config: &config.Nuke{}}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var filter config.Filter
+			err := yaml.Unmarshal([]byte(tc.yaml), &filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			match, err := n.MatchFilter(item, filter)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if match != tc.match {
+				t.Fatalf("Wrong result. Want: %t. Have: %t", tc.match, match)
+			}
+		})
+	}
+}
+
+func TestFilterGlobal(t *testing.T) {
+	n := &Nuke{
+		Config: &config.Nuke{
+			Accounts: map[string]config.Account{
+				"": {
+					Filters: config.Filters{
+						config.GlobalFiltersKey: {
+							{Property: "tag:nuke:keep", Type: config.FilterTypeExact, Value: "true"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	region := &Region{Name: "eu-west-1"}
+
+	cases := []struct {
+		name     string
+		item     *Item
+		filtered bool
+	}{
+		{
+			name: "Tagged",
+			item: &Item{Region: region, Type: "EC2Instance", Resource: &testPropertyResource{
+				properties: types.NewProperties().Set("tag:nuke:keep", "true"),
+			}},
+			filtered: true,
+		},
+		{
+			name: "Untagged",
+			item: &Item{Region: region, Type: "S3Bucket", Resource: &testPropertyResource{
+				properties: types.NewProperties().Set("Name", "foo"),
+			}},
+		},
+		{
+			name: "NoPropertySupport",
+			item: &Item{Region: region, Type: "IAMUser", Resource: &testResource{id: "foo"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := n.Filter(tc.item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if have := tc.item.State == ItemStateFiltered; have != tc.filtered {
+				t.Fatalf("Wrong filter result. Want: %t. Have: %t", tc.filtered, have)
+			}
+		})
+	}
+}
+
+// TestFilterGlobalSchema makes sure that global filters, which also match
+// resources without the property, skip types whose schema lacks it.
+func TestFilterGlobalSchema(t *testing.T) {
+	n := &Nuke{
+		Config: &config.Nuke{
+			Accounts: map[string]config.Account{
+				"": {
+					Filters: config.Filters{
+						config.GlobalFiltersKey: {
+							{Property: "tag:owner", Type: config.FilterTypeMissing},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	region := &Region{Name: "eu-west-1"}
+	resource := &testPropertyResource{properties: types.NewProperties().Set("Name", "foo")}
+
+	bucket := &Item{Region: region, Type: "S3Bucket", Resource: resource}
+	err := n.Filter(bucket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bucket.State != ItemStateFiltered {
+		t.Errorf("S3Bucket without owner tag should be filtered.")
+	}
+
+	// CloudFormationType does not declare any tags.
+	cfnType := &Item{Region: region, Type: "CloudFormationType", Resource: resource}
+	err = n.Filter(cfnType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfnType.State == ItemStateFiltered {
+		t.Errorf("CloudFormationType should not be filtered: %s", cfnType.Reason)
+	}
+}
+
+func TestFilterRegion(t *testing.T) {
+	n := &Nuke{
+		Config: &config.Nuke{
+			Accounts: map[string]config.Account{
+				"": {
+					Filters: config.Filters{
+						"EC2VPC": {config.NewExactFilter("vpc-keep")},
+					},
+					Regions: map[string]config.RegionConfig{
+						"eu-central-1": {
+							Filters: config.Filters{
+								"EC2VPC": {config.NewExactFilter("vpc-shared")},
+							},
+							ResourceTypes: config.ResourceTypes{
+								Targets: types.Collection{"EC2VPC", "EC2Subnet"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	cases := []struct {
+		region   string
+		id       string
+		filtered bool
+	}{
+		{"eu-central-1", "vpc-shared", true},
+		{"eu-central-1", "vpc-keep", true},
+		{"eu-central-1", "vpc-other", false},
+		{"eu-west-1", "vpc-shared", false},
+		{"eu-west-1", "vpc-keep", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.region+"/"+tc.id, func(t *testing.T) {
+			item := &Item{Region: &Region{Name: tc.region}, Type: "EC2VPC", Resource: &testResource{id: tc.id}}
+			err := n.Filter(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if have := item.State == ItemStateFiltered; have != tc.filtered {
+				t.Fatalf("Wrong filter result. Want: %t. Have: %t", tc.filtered, have)
+			}
+		})
+	}
+
+	want := types.Collection{"EC2Subnet", "EC2VPC"}
+	have := n.RegionResourceTypes("eu-central-1")
+	sort.Strings(have)
+	if !reflect.DeepEqual(want, have) {
+		t.Errorf("Wrong resource types in eu-central-1.\nWant: %#v\nHave: %#v", want, have)
+	}
+
+	if all := n.RegionResourceTypes("eu-west-1"); len(all) < 100 {
+		t.Errorf("Expected all resource types in eu-west-1, got %d", len(all))
+	}
+}
+
+func TestFilterExplain(t *testing.T) {
+	n := &Nuke{
+		Parameters: NukeParameters{Explain: true},
+		Config: &config.Nuke{
+			Presets: map[string]config.PresetDefinitions{
+				"common": {Filters: config.Filters{
+					"IAMRole": {
+						config.NewExactFilter("admin"),
+						config.NewExactFilter("deployer"),
+						config.NewExactFilter("ci"),
+					},
+				}},
+			},
+			Accounts: map[string]config.Account{
+				"": {
+					Presets: []string{"common"},
+					Filters: config.Filters{
+						"IAMRole": {{Type: config.FilterTypeGlob, Value: "adm*"}},
+					},
+				},
+			},
+		},
+	}
+
+	item := &Item{Region: &Region{Name: "global"}, Type: "IAMRole", Resource: &testResource{id: "admin"}}
+	err := n.Filter(item)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if item.FilteredBy == nil || item.FilteredBy.Origin.String() != "accounts..filters.IAMRole[0]" {
+		t.Fatalf("Wrong filter origin: %#v", item.FilteredBy)
+	}
+
+	wantReason := `filtered by accounts..filters.IAMRole[0] (property name, type glob, value "adm*")`
+	if item.Reason != wantReason {
+		t.Errorf("Wrong reason.\nWant: %s\nHave: %s", wantReason, item.Reason)
+	}
+
+	// The filter matches a resource, which is skipped anyway. It is used
+	// nevertheless, but does not change the reason.
+	skipped := &Item{Region: &Region{Name: "global"}, Type: "IAMRole", Resource: &testSkippedResource{testResource{id: "deployer"}}}
+	err = n.Filter(skipped)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if skipped.State != ItemStateFiltered || skipped.Reason != "cannot delete service linked role" {
+		t.Errorf("Wrong state of skipped item: %s (%s)", skipped.State, skipped.Reason)
+	}
+
+	n.items = Queue{item, skipped}
+
+	buf := new(bytes.Buffer)
+	Console = buf
+	defer SetOutputFormat(OutputFormatText)
+
+	n.PrintUnusedFilters()
+
+	want := "These filters did not match any resource:\n" +
+		"  presets.common.filters.IAMRole[2] (property name, type exact, value \"ci\")\n\n"
+	if buf.String() != want {
+		t.Errorf("Wrong report.\nWant: %q\nHave: %q", want, buf.String())
+	}
+}
+
+type testSkippedResource struct {
+	testResource
+}
+
+func (r *testSkippedResource) Filter() error {
+	return fmt.Errorf("cannot delete service linked role")
+}
+
+type testCreatedAtResource struct {
+	createdAt *time.Time
+}
+
+func (r *testCreatedAtResource) Remove() error {
+	return nil
+}
+
+func (r *testCreatedAtResource) CreatedAt() *time.Time {
+	return r.createdAt
+}
+
+func TestFilterOlderThan(t *testing.T) {
+	n := &Nuke{
+		Parameters: NukeParameters{OlderThan: 72 * time.Hour},
+		Config: &config.Nuke{
+			OlderThan: time.Hour,
+		},
+	}
+
+	region := &Region{Name: "eu-west-1"}
+	ago := func(d time.Duration) *time.Time {
+		t := time.Now().Add(-d)
+		return &t
+	}
+
+	cases := []struct {
+		name     string
+		resource *testCreatedAtResource
+		filtered bool
+	}{
+		{"Old", &testCreatedAtResource{createdAt: ago(96 * time.Hour)}, false},
+		{"New", &testCreatedAtResource{createdAt: ago(2 * time.Hour)}, true},
+		{"Unknown", &testCreatedAtResource{}, false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			item := &Item{Region: region, Type: "EC2Instance", Resource: tc.resource}
+			err := n.Filter(item)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if have := item.State == ItemStateFiltered; have != tc.filtered {
+				t.Fatalf("Wrong filter result. Want: %t. Have: %t", tc.filtered, have)
+			}
+		})
+	}
+
+	item := &Item{Region: region, Type: "EC2Instance", Resource: &testCreatedAtResource{
+		createdAt: ago(96 * time.Hour),
+	}}
+	value, err := item.GetProperty(CreatedAtProperty)
+	if err != nil || value == "" {
+		t.Fatalf("Expected canonical CreatedAt property. Have: %q (%v)", value, err)
+	}
+}
@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"strings"
+	"time"
 )
 
 type NukeParameters struct {
@@ -16,8 +17,29 @@ type NukeParameters struct {
 	Force      bool
 	ForceSleep int
 	Quiet      bool
+	Explain    bool
+	Output     string
+	PlanOut    string
 
-	MaxWaitRetries int
+	CheckpointPath string
+	ResumePath     string
+
+	MaxWaitRetries   int
+	ParallelRemovals int
+	ScanParallelism  int
+
+	ListTimeout   time.Duration
+	RemoveTimeout time.Duration
+
+	OlderThan time.Duration
+
+	AssumeRoleName     string
+	OrganizationalUnit string
+	ParallelAccounts   int
+
+	// OrganizationRoleARN is assumed with the provided credentials to
+	// access AWS Organizations, eg in the management account.
+	OrganizationRoleARN string
 }
 
 func (p *NukeParameters) Validate() error {
@@ -25,5 +47,54 @@ func (p *NukeParameters) Validate() error {
 		return fmt.Errorf("You have to specify the --config flag.\n")
 	}
 
+	if p.PlanOut != "" && p.NoDryRun {
+		return fmt.Errorf("The --out flag only works for dry runs. " +
+			"Use 'aws-nuke apply' to remove the resources of a plan.\n")
+	}
+
+	if p.ParallelRemovals < 1 {
+		return fmt.Errorf("The value for --parallel-removals must be at least 1.\n")
+	}
+
+	if p.ScanParallelism < 1 {
+		return fmt.Errorf("The value for --scan-parallelism must be at least 1.\n")
+	}
+
+	if p.ListTimeout < 0 || p.RemoveTimeout < 0 {
+		return fmt.Errorf("The values for --list-timeout and --remove-timeout must not be negative.\n")
+	}
+
+	if p.OlderThan < 0 {
+		return fmt.Errorf("The value for --older-than must not be negative.\n")
+	}
+
+	if p.OrganizationalUnit != "" && p.AssumeRoleName == "" {
+		return fmt.Errorf("The --organizational-unit flag must be used together with --assume-role-name.\n")
+	}
+
+	if p.AssumeRoleName != "" && p.PlanOut != "" {
+		return fmt.Errorf("Plan files are not supported when nuking multiple accounts.\n")
+	}
+
+	if p.AssumeRoleName != "" && (p.CheckpointPath != "" || p.ResumePath != "") {
+		return fmt.Errorf("Checkpoints are not supported when nuking multiple accounts.\n")
+	}
+
+	if p.ParallelAccounts < 1 {
+		return fmt.Errorf("The value for --parallel-accounts must be at least 1.\n")
+	}
+
+	if p.ParallelAccounts > 1 && !p.Force {
+		return fmt.Errorf("Nuking accounts in parallel requires --force, since there is no way " +
+			"to answer the prompts of multiple accounts at the same time.\n")
+	}
+
+	switch p.Output {
+	case OutputFormatText, OutputFormatJSON:
+	default:
+		return fmt.Errorf("Invalid value '%s' for --output. Must be one of '%s' or '%s'.\n",
+			p.Output, OutputFormatText, OutputFormatJSON)
+	}
+
 	return nil
 }
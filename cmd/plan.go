@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+	"github.com/rebuy-de/aws-nuke/v2/resources"
+)
+
+// A Plan is the reviewed result of a dry run. Applying it only removes the
+// resources that are listed in it, even if more were created in the meantime.
+type Plan struct {
+	AccountID  string     `json:"account-id"`
+	ConfigHash string     `json:"config-hash"`
+	Items      []PlanItem `json:"items"`
+}
+
+// PlanItem holds everything needed to find a resource again after a rescan.
+type PlanItem struct {
+	Region     string           `json:"region"`
+	Type       string           `json:"resource-type"`
+	ID         string           `json:"id,omitempty"`
+	Properties types.Properties `json:"properties,omitempty"`
+}
+
+func NewPlan(accountID, configHash string, queue Queue) *Plan {
+	plan := &Plan{
+		AccountID:  accountID,
+		ConfigHash: configHash,
+		Items:      []PlanItem{},
+	}
+
+	for _, item := range queue {
+		if item.State != ItemStateNew {
+			continue
+		}
+
+		plan.Items = append(plan.Items, NewPlanItem(item))
+	}
+
+	return plan
+}
+
+func NewPlanItem(item *Item) PlanItem {
+	planItem := PlanItem{
+		Region: item.Region.Name,
+		Type:   item.Type,
+	}
+
+	if stringer, ok := item.Resource.(resources.LegacyStringer); ok {
+		planItem.ID = stringer.String()
+	}
+
+	if getter, ok := item.Resource.(resources.ResourcePropertyGetter); ok {
+		planItem.Properties = getter.Properties()
+	}
+
+	return planItem
+}
+
+// Matches checks whether the item describes the same resource. Like
+// Item.Equals it compares the legacy ID if the resource has one and falls
+// back to comparing all properties otherwise.
+func (pi PlanItem) Matches(item *Item) bool {
+	if pi.Region != item.Region.Name || pi.Type != item.Type {
+		return false
+	}
+
+	if stringer, ok := item.Resource.(resources.LegacyStringer); ok {
+		return pi.ID == stringer.String()
+	}
+
+	if getter, ok := item.Resource.(resources.ResourcePropertyGetter); ok {
+		return pi.Properties.Equals(getter.Properties())
+	}
+
+	return false
+}
+
+func LoadPlan(path string) (*Plan, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	plan := new(Plan)
+	err = json.Unmarshal(raw, plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse plan file %s: %w", path, err)
+	}
+
+	return plan, nil
+}
+
+func (p *Plan) Write(path string) error {
+	raw, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, append(raw, '\n'), 0600)
+}
+
+// Validate makes sure that the plan is applied to the same account and with
+// the same config it was created with.
+func (p *Plan) Validate(accountID, configHash string) error {
+	if p.AccountID != accountID {
+		return fmt.Errorf("The plan was created for the account with the ID %s, "+
+			"but you are trying to apply it to %s. Aborting.", p.AccountID, accountID)
+	}
+
+	if p.ConfigHash != configHash {
+		return fmt.Errorf("The config file changed since the plan was created. " +
+			"Create a new plan and review it again. Aborting.")
+	}
+
+	return nil
+}
+
+func (p *Plan) ResourceTypes() types.Collection {
+	seen := map[string]bool{}
+	result := types.Collection{}
+	for _, item := range p.Items {
+		if !seen[item.Type] {
+			seen[item.Type] = true
+			result = append(result, item.Type)
+		}
+	}
+
+	return result
+}
+
+func (p *Plan) HasRegion(region string) bool {
+	for _, item := range p.Items {
+		if item.Region == region {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Contains checks whether the item was approved by the plan.
+func (p *Plan) Contains(item *Item) bool {
+	for _, planItem := range p.Items {
+		if planItem.Matches(item) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+)
+
+func TestPlanRoundTrip(t *testing.T) {
+	region := &Region{Name: "eu-west-1"}
+
+	queue := Queue{
+		{Region: region, Type: "EC2Instance", State: ItemStateNew, Resource: &testResource{id: "i-1"}},
+		{Region: region, Type: "EC2Instance", State: ItemStateFiltered, Resource: &testResource{id: "i-2"}},
+		{Region: region, Type: "IAMRole", State: ItemStateNew, Resource: &testPropertyResource{
+			properties: types.NewProperties().Set("Name", "admin"),
+		}},
+	}
+
+	path := filepath.Join(t.TempDir(), "plan.json")
+	err := NewPlan("1234567890", "abc", queue).Write(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err := LoadPlan(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := plan.Validate("1234567890", "abc"); err != nil {
+		t.Fatalf("Unexpected validation error: %v", err)
+	}
+
+	if err := plan.Validate("0987654321", "abc"); err == nil {
+		t.Fatalf("Expected error for different account.")
+	}
+
+	if err := plan.Validate("1234567890", "def"); err == nil {
+		t.Fatalf("Expected error for different config.")
+	}
+
+	cases := []struct {
+		name string
+		item *Item
+		want bool
+	}{
+		{
+			name: "PlannedID",
+			item: &Item{Region: region, Type: "EC2Instance", Resource: &testResource{id: "i-1"}},
+			want: true,
+		},
+		{
+			name: "FilteredID",
+			item: &Item{Region: region, Type: "EC2Instance", Resource: &testResource{id: "i-2"}},
+		},
+		{
+			name: "NewID",
+			item: &Item{Region: region, Type: "EC2Instance", Resource: &testResource{id: "i-3"}},
+		},
+		{
+			name: "OtherRegion",
+			item: &Item{Region: &Region{Name: "us-east-1"}, Type: "EC2Instance", Resource: &testResource{id: "i-1"}},
+		},
+		{
+			name: "PlannedProperties",
+			item: &Item{Region: region, Type: "IAMRole", Resource: &testPropertyResource{
+				properties: types.NewProperties().Set("Name", "admin"),
+			}},
+			want: true,
+		},
+		{
+			name: "ChangedProperties",
+			item: &Item{Region: region, Type: "IAMRole", Resource: &testPropertyResource{
+				properties: types.NewProperties().Set("Name", "other"),
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if have := plan.Contains(tc.item); have != tc.want {
+				t.Fatalf("Wrong result. Want: %t. Have: %t", tc.want, have)
+			}
+		})
+	}
+}
@@ -1,8 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
 	"github.com/rebuy-de/aws-nuke/v2/resources"
 )
 
@@ -18,6 +21,40 @@ const (
 	ItemStateFinished
 )
 
+func (s ItemState) String() string {
+	switch s {
+	case ItemStateNew:
+		return "new"
+	case ItemStatePending:
+		return "pending"
+	case ItemStateWaiting:
+		return "waiting"
+	case ItemStateFailed:
+		return "failed"
+	case ItemStateFiltered:
+		return "filtered"
+	case ItemStateFinished:
+		return "finished"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(s))
+	}
+}
+
+func (s ItemState) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+func (s *ItemState) UnmarshalText(text []byte) error {
+	for state := ItemStateNew; state <= ItemStateFinished; state++ {
+		if state.String() == string(text) {
+			*s = state
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown item state '%s'", string(text))
+}
+
 // An Item describes an actual AWS resource entity with the current state and
 // some metadata.
 type Item struct {
@@ -26,16 +63,36 @@ type Item struct {
 	State  ItemState
 	Reason string
 
+	// FilteredBy is the filter of the config, which matched the resource.
+	FilteredBy *config.FilterWithOrigin
+
 	Region *Region
 	Type   string
+
+	// Snapshot is the ARN of the final snapshot taken before the removal.
+	// SnapshotDone is set, once the snapshot is usable.
+	Snapshot     string
+	SnapshotDone bool
+
+	// FinishedAt is the time when the removal of the resource was confirmed.
+	FinishedAt time.Time
 }
 
 func (i *Item) Print() {
+	if OutputFormat == OutputFormatJSON {
+		i.printEvent()
+		return
+	}
+
 	switch i.State {
 	case ItemStateNew:
 		Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "would remove")
 	case ItemStatePending:
-		Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "triggered remove")
+		if i.Snapshot != "" {
+			Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "triggered remove, final snapshot "+i.Snapshot)
+		} else {
+			Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "triggered remove")
+		}
 	case ItemStateWaiting:
 		Log(i.Region, i.Type, i.Resource, ReasonWaitPending, "waiting")
 	case ItemStateFailed:
@@ -47,14 +104,59 @@ func (i *Item) Print() {
 	}
 }
 
+func (i *Item) printEvent() {
+	event := Event{
+		Event:        "resource",
+		Account:      i.Region.Account,
+		Region:       i.Region.Name,
+		ResourceType: i.Type,
+		State:        i.State.String(),
+		Reason:       i.Reason,
+		Snapshot:     i.Snapshot,
+	}
+
+	if i.FilteredBy != nil {
+		event.Filter = i.FilteredBy.Origin.String()
+	}
+
+	if stringer, ok := i.Resource.(resources.LegacyStringer); ok {
+		event.ID = stringer.String()
+	}
+
+	if getter, ok := i.Resource.(resources.ResourcePropertyGetter); ok {
+		event.Properties = getter.Properties()
+	}
+
+	LogEvent(event)
+}
+
 // List gets all resource items of the same resource type like the Item.
-func (i *Item) List() ([]resources.Resource, error) {
-	lister := resources.GetLister(i.Type)
+func (i *Item) List(ctx context.Context) ([]resources.Resource, error) {
+	lister := resources.GetContextLister(i.Type)
 	sess, err := i.Region.Session(i.Type)
 	if err != nil {
 		return nil, err
 	}
-	return lister(sess)
+	return lister(ctx, sess)
+}
+
+// CreatedAtProperty is a property, which is available for all resources with
+// a known creation time, regardless of how the resource names it.
+const CreatedAtProperty = "CreatedAt"
+
+// CreatedAt returns the creation time of the resource, if it is known.
+func (i *Item) CreatedAt() (time.Time, bool) {
+	getter, ok := i.Resource.(resources.CreatedAtGetter)
+	if !ok {
+		return time.Time{}, false
+	}
+
+	createdAt := getter.CreatedAt()
+	if createdAt == nil || createdAt.IsZero() {
+		return time.Time{}, false
+	}
+
+	return *createdAt, true
 }
 
 func (i *Item) GetProperty(key string) (string, error) {
@@ -66,6 +168,12 @@ func (i *Item) GetProperty(key string) (string, error) {
 		return stringer.String(), nil
 	}
 
+	if key == CreatedAtProperty {
+		if createdAt, ok := i.CreatedAt(); ok {
+			return createdAt.UTC().Format(time.RFC3339), nil
+		}
+	}
+
 	getter, ok := i.Resource.(resources.ResourcePropertyGetter)
 	if !ok {
 		return "", fmt.Errorf("%T does not support custom properties", i.Resource)
@@ -74,6 +182,56 @@ func (i *Item) GetProperty(key string) (string, error) {
 	return getter.Properties().Get(key), nil
 }
 
+// SupportsProperties checks whether the resource has the necessary interfaces
+// to look up all of the given properties and whether the schema of its type
+// declares them. An empty key refers to the legacy ID.
+func (i *Item) SupportsProperties(keys ...string) bool {
+	for _, key := range keys {
+		var ok bool
+		switch key {
+		case "":
+			_, ok = i.Resource.(resources.LegacyStringer)
+		case CreatedAtProperty:
+			_, ok = i.Resource.(resources.CreatedAtGetter)
+			if !ok {
+				_, ok = i.Resource.(resources.ResourcePropertyGetter)
+			}
+		default:
+			_, ok = i.Resource.(resources.ResourcePropertyGetter)
+			ok = ok && resources.HasProperty(i.Type, key)
+		}
+
+		if !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// HasProperty checks whether the resource has the property set at all, which
+// cannot be told from GetProperty for empty values.
+func (i *Item) HasProperty(key string) (bool, error) {
+	if key == "" {
+		_, ok := i.Resource.(resources.LegacyStringer)
+		return ok, nil
+	}
+
+	if key == CreatedAtProperty {
+		if _, ok := i.CreatedAt(); ok {
+			return true, nil
+		}
+	}
+
+	getter, ok := i.Resource.(resources.ResourcePropertyGetter)
+	if !ok {
+		return false, fmt.Errorf("%T does not support custom properties", i.Resource)
+	}
+
+	_, ok = getter.Properties()[key]
+	return ok, nil
+}
+
 func (i *Item) Equals(o resources.Resource) bool {
 	iType := fmt.Sprintf("%T", i.Resource)
 	oType := fmt.Sprintf("%T", o)
@@ -120,3 +278,42 @@ func (q Queue) Count(states ...ItemState) int {
 	}
 	return count
 }
+
+// Blocked returns all new items which cannot be removed yet, because there
+// are still items of a resource type they depend on left in the same region.
+// Failed items do not block others, since they might never succeed and
+// otherwise would keep their dependents from being tried at all.
+func (q Queue) Blocked() map[*Item]string {
+	remaining := map[string]map[string]int{}
+	for _, item := range q {
+		switch item.State {
+		case ItemStateNew, ItemStatePending, ItemStateWaiting:
+			region := item.Region.Name
+			if remaining[region] == nil {
+				remaining[region] = map[string]int{}
+			}
+			remaining[region][item.Type]++
+		}
+	}
+
+	blocked := map[*Item]string{}
+	for _, item := range q {
+		if item.State != ItemStateNew {
+			continue
+		}
+
+		getter, ok := item.Resource.(resources.DependencyGetter)
+		if !ok {
+			continue
+		}
+
+		for _, dependency := range getter.DependsOn() {
+			if remaining[item.Region.Name][dependency] > 0 {
+				blocked[item] = dependency
+				break
+			}
+		}
+	}
+
+	return blocked
+}
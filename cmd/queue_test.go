@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"testing"
+)
+
+type testResource struct {
+	id        string
+	dependsOn []string
+}
+
+func (r *testResource) Remove() error {
+	return nil
+}
+
+func (r *testResource) String() string {
+	return r.id
+}
+
+func (r *testResource) DependsOn() []string {
+	return r.dependsOn
+}
+
+func TestQueueBlocked(t *testing.T) {
+	euWest1 := &Region{Name: "eu-west-1"}
+	usEast1 := &Region{Name: "us-east-1"}
+
+	newItem := func(region *Region, resourceType string, state ItemState, dependsOn ...string) *Item {
+		return &Item{
+			Region:   region,
+			Type:     resourceType,
+			State:    state,
+			Resource: &testResource{id: resourceType, dependsOn: dependsOn},
+		}
+	}
+
+	cases := []struct {
+		name    string
+		queue   Queue
+		blocked []int
+	}{
+		{
+			name: "NoDependencies",
+			queue: Queue{
+				newItem(euWest1, "Subnet", ItemStateNew),
+				newItem(euWest1, "VPC", ItemStateNew),
+			},
+		},
+		{
+			name: "ChildNew",
+			queue: Queue{
+				newItem(euWest1, "Subnet", ItemStateNew),
+				newItem(euWest1, "VPC", ItemStateNew, "Subnet"),
+			},
+			blocked: []int{1},
+		},
+		{
+			name: "ChildWaiting",
+			queue: Queue{
+				newItem(euWest1, "Subnet", ItemStateWaiting),
+				newItem(euWest1, "VPC", ItemStateNew, "Subnet"),
+			},
+			blocked: []int{1},
+		},
+		{
+			name: "ChildDone",
+			queue: Queue{
+				newItem(euWest1, "Subnet", ItemStateFinished),
+				newItem(euWest1, "Subnet", ItemStateFiltered),
+				newItem(euWest1, "VPC", ItemStateNew, "Subnet"),
+			},
+		},
+		{
+			name: "ChildFailed",
+			queue: Queue{
+				newItem(euWest1, "Subnet", ItemStateFailed),
+				newItem(euWest1, "VPC", ItemStateNew, "Subnet"),
+			},
+		},
+		{
+			name: "ChildInOtherRegion",
+			queue: Queue{
+				newItem(usEast1, "Subnet", ItemStateNew),
+				newItem(euWest1, "VPC", ItemStateNew, "Subnet"),
+			},
+		},
+		{
+			name: "ParentAlreadyTriggered",
+			queue: Queue{
+				newItem(euWest1, "Subnet", ItemStateNew),
+				newItem(euWest1, "VPC", ItemStateFailed, "Subnet"),
+			},
+		},
+		{
+			name: "Chain",
+			queue: Queue{
+				newItem(euWest1, "Instance", ItemStatePending),
+				newItem(euWest1, "Subnet", ItemStateNew, "Instance"),
+				newItem(euWest1, "VPC", ItemStateNew, "Subnet"),
+			},
+			blocked: []int{1, 2},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			blocked := tc.queue.Blocked()
+			if len(blocked) != len(tc.blocked) {
+				t.Fatalf("Wrong number of blocked items. Want: %d. Have: %d", len(tc.blocked), len(blocked))
+			}
+
+			for _, i := range tc.blocked {
+				if _, ok := blocked[tc.queue[i]]; !ok {
+					t.Fatalf("Expected item %d (%s) to be blocked.", i, tc.queue[i].Type)
+				}
+			}
+		})
+	}
+}
@@ -15,7 +15,12 @@ type SessionFactory func(regionName, svcType string) (*session.Session, error)
 type ResourceTypeResolver func(regionName, resourceType string) string
 
 type Region struct {
-	Name            string
+	Name string
+
+	// Account is the ID of the account the region belongs to, so the
+	// output of accounts which are nuked in parallel can be told apart.
+	Account string
+
 	NewSession      SessionFactory
 	ResTypeResolver ResourceTypeResolver
 
@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"sync"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// RemoveAll calls HandleRemove for all given items. At most
+// Parameters.ParallelRemovals removals run at the same time and additionally
+// no service exceeds its limit from the config. Every item is only touched by
+// a single goroutine, so the state transitions stay the same as when removing
+// them one after another. Once the context is cancelled, no new removals are
+// started, but running ones are waited for.
+func (n *Nuke) RemoveAll(ctx context.Context, items []*Item) {
+	parallel := n.Parameters.ParallelRemovals
+	if parallel < 1 {
+		parallel = 1
+	}
+
+	global := semaphore.NewWeighted(int64(parallel))
+	services := map[string]*semaphore.Weighted{}
+
+	wg := new(sync.WaitGroup)
+	for _, item := range items {
+		var limiter *semaphore.Weighted
+
+		service, limit := n.Config.ServiceRemovalLimit(item.Type)
+		if service != "" {
+			limiter = services[service]
+			if limiter == nil {
+				limiter = semaphore.NewWeighted(int64(limit))
+				services[service] = limiter
+			}
+		}
+
+		wg.Add(1)
+		go func(item *Item, limiter *semaphore.Weighted) {
+			defer wg.Done()
+
+			// Acquire the service slot first, so items of a saturated
+			// service do not occupy global slots while waiting.
+			if limiter != nil {
+				if limiter.Acquire(ctx, 1) != nil {
+					return
+				}
+				defer limiter.Release(1)
+			}
+
+			if global.Acquire(ctx, 1) != nil {
+				return
+			}
+			defer global.Release(1)
+
+			if ctx.Err() != nil {
+				return
+			}
+
+			n.HandleRemove(ctx, item)
+		}(item, limiter)
+	}
+
+	wg.Wait()
+}
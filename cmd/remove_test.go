@@ -0,0 +1,184 @@
+package cmd
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+)
+
+type concurrencyCounter struct {
+	mu      sync.Mutex
+	current int
+	max     int
+}
+
+func (c *concurrencyCounter) enter() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current++
+	if c.current > c.max {
+		c.max = c.current
+	}
+}
+
+func (c *concurrencyCounter) leave() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.current--
+}
+
+type countingResource struct {
+	counters []*concurrencyCounter
+}
+
+func (r *countingResource) Remove() error {
+	for _, c := range r.counters {
+		c.enter()
+	}
+
+	time.Sleep(10 * time.Millisecond)
+
+	for _, c := range r.counters {
+		c.leave()
+	}
+
+	return nil
+}
+
+func TestRemoveAllLimits(t *testing.T) {
+	var (
+		total = new(concurrencyCounter)
+		iam   = new(concurrencyCounter)
+	)
+
+	n := &Nuke{
+		Parameters: NukeParameters{ParallelRemovals: 4},
+		Config: &config.Nuke{
+			ServiceRemovalLimits: map[string]int{"iam": 1},
+		},
+	}
+
+	items := []*Item{}
+	for i := 0; i < 10; i++ {
+		items = append(items, &Item{
+			Type:     "IAMRole",
+			State:    ItemStateNew,
+			Resource: &countingResource{counters: []*concurrencyCounter{total, iam}},
+		})
+		items = append(items, &Item{
+			Type:     "S3Bucket",
+			State:    ItemStateNew,
+			Resource: &countingResource{counters: []*concurrencyCounter{total}},
+		})
+	}
+
+	n.RemoveAll(context.Background(), items)
+
+	if total.max > 4 {
+		t.Errorf("Too many concurrent removals. Want at most 4. Have: %d", total.max)
+	}
+
+	if iam.max != 1 {
+		t.Errorf("Wrong number of concurrent IAM removals. Want: 1. Have: %d", iam.max)
+	}
+
+	for _, item := range items {
+		if item.State != ItemStatePending {
+			t.Fatalf("Wrong item state. Want: %s. Have: %s", ItemStatePending, item.State)
+		}
+	}
+}
+
+func TestRemoveAllCancelled(t *testing.T) {
+	counter := new(concurrencyCounter)
+
+	n := &Nuke{
+		Parameters: NukeParameters{ParallelRemovals: 2},
+		Config:     &config.Nuke{},
+	}
+
+	items := []*Item{}
+	for i := 0; i < 5; i++ {
+		items = append(items, &Item{
+			Type:     "S3Bucket",
+			State:    ItemStateNew,
+			Resource: &countingResource{counters: []*concurrencyCounter{counter}},
+		})
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	n.RemoveAll(ctx, items)
+
+	if counter.max != 0 {
+		t.Fatalf("Resources were removed after the context was cancelled.")
+	}
+
+	for _, item := range items {
+		if item.State != ItemStateNew {
+			t.Fatalf("Wrong item state. Want: %s. Have: %s", ItemStateNew, item.State)
+		}
+	}
+}
+
+type blockingResource struct {
+	release chan struct{}
+	calls   atomic.Int32
+}
+
+func (r *blockingResource) Remove() error {
+	r.calls.Add(1)
+	<-r.release
+	return nil
+}
+
+func TestHandleRemoveTimeout(t *testing.T) {
+	n := &Nuke{
+		Parameters: NukeParameters{RemoveTimeout: 10 * time.Millisecond},
+		Config:     &config.Nuke{},
+	}
+
+	resource := &blockingResource{release: make(chan struct{})}
+
+	item := &Item{
+		Type:     "CloudFormationStack",
+		State:    ItemStateNew,
+		Resource: resource,
+	}
+
+	n.HandleRemove(context.Background(), item)
+
+	if item.State != ItemStateFailed {
+		t.Fatalf("Wrong item state. Want: %s. Have: %s", ItemStateFailed, item.State)
+	}
+
+	if !strings.Contains(item.Reason, "timed out") {
+		t.Fatalf("Wrong failure reason: %s", item.Reason)
+	}
+
+	// The retry waits for the removal, which still runs in the background,
+	// instead of starting a second one.
+	n.HandleRemove(context.Background(), item)
+	if item.State != ItemStateFailed {
+		t.Fatalf("Wrong item state. Want: %s. Have: %s", ItemStateFailed, item.State)
+	}
+
+	close(resource.release)
+	n.Parameters.RemoveTimeout = time.Minute
+	n.HandleRemove(context.Background(), item)
+	if item.State != ItemStatePending {
+		t.Fatalf("Wrong item state. Want: %s. Have: %s (%s)", ItemStatePending, item.State, item.Reason)
+	}
+
+	if calls := resource.calls.Load(); calls != 1 {
+		t.Fatalf("Wrong number of removals. Want: 1. Have: %d", calls)
+	}
+}
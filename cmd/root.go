@@ -1,9 +1,12 @@
 package cmd
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"os"
 	"sort"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
@@ -19,6 +22,8 @@ func NewRootCommand() *cobra.Command {
 		creds         awsutil.Credentials
 		defaultRegion string
 		verbose       bool
+		recordDir     string
+		replayDir     string
 	)
 
 	command := &cobra.Command{
@@ -27,7 +32,7 @@ func NewRootCommand() *cobra.Command {
 		Long:  `A tool which removes every resource from an AWS account.  Use it with caution, since it cannot distinguish between production and non-production.`,
 	}
 
-	command.PreRun = func(cmd *cobra.Command, args []string) {
+	command.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
 		log.SetLevel(log.InfoLevel)
 		if verbose {
 			log.SetLevel(log.DebugLevel)
@@ -35,6 +40,17 @@ func NewRootCommand() *cobra.Command {
 		log.SetFormatter(&log.TextFormatter{
 			EnvironmentOverrideColors: true,
 		})
+
+		var err error
+		switch {
+		case recordDir != "" && replayDir != "":
+			err = fmt.Errorf("The --record and --replay flags cannot be used together.\n")
+		case recordDir != "":
+			creds.Cassette, err = awsutil.NewRecorder(recordDir)
+		case replayDir != "":
+			creds.Cassette, err = awsutil.LoadCassette(replayDir)
+		}
+		return err
 	}
 
 	command.RunE = func(cmd *cobra.Command, args []string) error {
@@ -45,51 +61,39 @@ func NewRootCommand() *cobra.Command {
 			return err
 		}
 
-		if !creds.HasKeys() && !creds.HasProfile() && defaultRegion != "" {
-			creds.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
-			creds.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
-		}
-		err = creds.Validate()
-		if err != nil {
-			return err
-		}
+		SetOutputFormat(params.Output)
 
 		command.SilenceUsage = true
 
-		config, err := config.Load(params.ConfigPath)
-		if err != nil {
-			log.Errorf("Failed to parse config file %s", params.ConfigPath)
-			return err
-		}
+		ctx, cancel := SignalContext(context.Background())
+		defer cancel()
 
-		if defaultRegion != "" {
-			awsutil.DefaultRegionID = defaultRegion
-			switch defaultRegion {
-			case endpoints.UsEast1RegionID, endpoints.UsEast2RegionID, endpoints.UsWest1RegionID, endpoints.UsWest2RegionID:
-				awsutil.DefaultAWSPartitionID = endpoints.AwsPartitionID
-			case endpoints.UsGovEast1RegionID, endpoints.UsGovWest1RegionID:
-				awsutil.DefaultAWSPartitionID = endpoints.AwsUsGovPartitionID
-			case endpoints.CnNorth1RegionID, endpoints.CnNorthwest1RegionID:
-				awsutil.DefaultAWSPartitionID = endpoints.AwsCnPartitionID
-			default:
-				if config.CustomEndpoints.GetRegion(defaultRegion) == nil {
-					err = fmt.Errorf("The custom region '%s' must be specified in the configuration 'endpoints'", defaultRegion)
-					log.Error(err.Error())
-					return err
-				}
+		if params.AssumeRoleName != "" {
+			config, err := LoadConfigFromFlags(params, &creds, defaultRegion)
+			if err != nil {
+				return err
 			}
+
+			return RunMultiAccount(ctx, params, creds, config)
+		}
+
+		if params.ResumePath != "" && params.CheckpointPath == "" {
+			params.CheckpointPath = params.ResumePath
 		}
 
-		account, err := awsutil.NewAccount(creds, config.CustomEndpoints)
+		n, err := NewNukeFromFlags(params, creds, defaultRegion)
 		if err != nil {
 			return err
 		}
 
-		n := NewNuke(params, *account)
-
-		n.Config = config
+		if params.ResumePath != "" {
+			n.Checkpoint, err = LoadCheckpoint(params.ResumePath)
+			if err != nil {
+				return err
+			}
+		}
 
-		return n.Run()
+		return n.Run(ctx)
 	}
 
 	command.PersistentFlags().BoolVarP(
@@ -124,6 +128,31 @@ func NewRootCommand() *cobra.Command {
 		"AWS IAM role arn to assume. "+
 			"The credentials provided via --access-key-id or --profile must "+
 			"be allowed to assume this role. ")
+	command.PersistentFlags().StringVar(
+		&params.AssumeRoleName, "assume-role-name", "",
+		"Nuke multiple accounts by assuming the IAM role with this name in each of them "+
+			"(eg OrganizationAccountAccessRole). By default all accounts from the config are nuked.")
+	command.PersistentFlags().StringVar(
+		&params.OrganizationalUnit, "organizational-unit", "",
+		"Nuke all accounts in this AWS Organizations OU (eg ou-ab12-34cd56ef) instead of the "+
+			"ones from the config. Must be used together with --assume-role-name.")
+	command.PersistentFlags().StringVar(
+		&params.OrganizationRoleARN, "organization-role-arn", "",
+		"AWS IAM role arn to assume for looking up accounts in AWS Organizations, eg in the "+
+			"management account. It is assumed with the credentials provided via --access-key-id "+
+			"or --profile. By default these credentials are used directly.")
+	command.PersistentFlags().IntVar(
+		&params.ParallelAccounts, "parallel-accounts", 1,
+		"Number of accounts which are nuked at the same time, if --assume-role-name is set. "+
+			"Values greater than 1 require --force.")
+	command.PersistentFlags().StringVar(
+		&recordDir, "record", "",
+		"Record all AWS requests and responses into this directory. Secret headers and "+
+			"credentials are removed, so the recording can be attached to bug reports.")
+	command.PersistentFlags().StringVar(
+		&replayDir, "replay", "",
+		"Replay the AWS responses recorded with --record from this directory "+
+			"instead of sending requests to AWS.")
 	command.PersistentFlags().StringVar(
 		&defaultRegion, "default-region", "",
 		"Custom default region name.")
@@ -158,16 +187,126 @@ func NewRootCommand() *cobra.Command {
 		&params.MaxWaitRetries, "max-wait-retries", 0,
 		"If specified, the program will exit if resources are stuck in waiting for this many iterations. "+
 			"0 (default) disables early exit.")
+	command.PersistentFlags().IntVar(
+		&params.ParallelRemovals, "parallel-removals", 1,
+		"Number of resources which are removed at the same time. "+
+			"Use 'service-removal-limits' in the config to limit single services further.")
+	command.PersistentFlags().IntVar(
+		&params.ScanParallelism, "scan-parallelism", ScannerParallelQueries,
+		"Number of resource types which are listed at the same time, shared by all regions.")
+	command.PersistentFlags().DurationVar(
+		&params.ListTimeout, "list-timeout", 0,
+		"Maximum time a single resource type may take to be listed (eg 5m). "+
+			"0 (default) disables the timeout.")
+	command.PersistentFlags().DurationVar(
+		&params.RemoveTimeout, "remove-timeout", 0,
+		"Maximum time the removal of a single resource may take (eg 10m). "+
+			"0 (default) disables the timeout.")
+	command.PersistentFlags().DurationVar(
+		&params.OlderThan, "older-than", 0,
+		"Only remove resources which were created longer ago than this (eg 72h). "+
+			"Resource types without a creation time are not affected. Overrides 'older-than' from the config.")
 	command.PersistentFlags().BoolVarP(
 		&params.Quiet, "quiet", "q", false,
 		"Don't show filtered resources.")
+	command.PersistentFlags().BoolVar(
+		&params.Explain, "explain", false,
+		"Show which filter of the config kept each filtered resource and list the filters, "+
+			"which did not match any resource, at the end of the run.")
+	command.PersistentFlags().StringVar(
+		&params.PlanOut, "out", "",
+		"If specified, the resources of the dry run are saved to this plan file. "+
+			"Use 'aws-nuke apply' to remove exactly these resources later.")
+	command.PersistentFlags().StringVar(
+		&params.CheckpointPath, "checkpoint", "",
+		"If specified, the state of all resources is saved to this file after every removal pass, "+
+			"so an interrupted run can be continued with --resume.")
+	command.PersistentFlags().StringVar(
+		&params.ResumePath, "resume", "",
+		"Continue an interrupted run from this checkpoint file. Resource types which are done "+
+			"are not scanned again. The checkpoint keeps being updated, unless --checkpoint "+
+			"points to another file.")
+	command.PersistentFlags().StringVarP(
+		&params.Output, "output", "o", OutputFormatText,
+		"Output format for resources and summaries. "+
+			"Either 'text' or 'json' (one JSON event per line). "+
+			"In JSON mode all other messages are written to stderr.")
 
 	command.AddCommand(NewVersionCommand())
+	command.AddCommand(NewApplyCommand(&params, &creds, &defaultRegion))
 	command.AddCommand(NewResourceTypesCommand())
+	command.AddCommand(NewConfigCommand(&params))
 
 	return command
 }
 
+// NewNukeFromFlags validates the credentials, loads the config and looks up
+// the account, which are shared by all commands that actually nuke.
+func NewNukeFromFlags(params NukeParameters, creds awsutil.Credentials, defaultRegion string) (*Nuke, error) {
+	config, err := LoadConfigFromFlags(params, &creds, defaultRegion)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := awsutil.NewAccount(creds, config.CustomEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	n := NewNuke(params, *account)
+
+	n.Config = config
+	n.Organization = &account.Credentials
+	if params.OrganizationRoleARN != "" {
+		n.Organization = creds.WithAssumedRole(params.OrganizationRoleARN)
+	}
+
+	return n, nil
+}
+
+// LoadConfigFromFlags validates the credentials, loads the config and sets up
+// the default region.
+func LoadConfigFromFlags(params NukeParameters, creds *awsutil.Credentials, defaultRegion string) (*config.Nuke, error) {
+	var err error
+
+	if !creds.HasKeys() && !creds.HasProfile() && defaultRegion != "" {
+		creds.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+		creds.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+	}
+	err = creds.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	config, err := config.Load(params.ConfigPath)
+	if err != nil {
+		log.Errorf("Failed to parse config file %s", params.ConfigPath)
+		return nil, err
+	}
+
+	creds.Throttler = awsutil.NewThrottler(config.Retry)
+
+	if defaultRegion != "" {
+		awsutil.DefaultRegionID = defaultRegion
+		switch defaultRegion {
+		case endpoints.UsEast1RegionID, endpoints.UsEast2RegionID, endpoints.UsWest1RegionID, endpoints.UsWest2RegionID:
+			awsutil.DefaultAWSPartitionID = endpoints.AwsPartitionID
+		case endpoints.UsGovEast1RegionID, endpoints.UsGovWest1RegionID:
+			awsutil.DefaultAWSPartitionID = endpoints.AwsUsGovPartitionID
+		case endpoints.CnNorth1RegionID, endpoints.CnNorthwest1RegionID:
+			awsutil.DefaultAWSPartitionID = endpoints.AwsCnPartitionID
+		default:
+			if config.CustomEndpoints.GetRegion(defaultRegion) == nil {
+				err = fmt.Errorf("The custom region '%s' must be specified in the configuration 'endpoints'", defaultRegion)
+				log.Error(err.Error())
+				return nil, err
+			}
+		}
+	}
+
+	return config, nil
+}
+
 func NewResourceTypesCommand() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "resource-types",
@@ -182,5 +321,58 @@ func NewResourceTypesCommand() *cobra.Command {
 		},
 	}
 
+	cmd.AddCommand(&cobra.Command{
+		Use:   "describe <Type>",
+		Short: "shows the properties and options of a resource type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			schema := resources.GetSchema(args[0])
+			if schema == nil && strings.HasPrefix(args[0], "AWS::") {
+				schema = &resources.ResourceSchema{Name: args[0], CloudControl: args[0]}
+			}
+
+			if schema == nil {
+				cmd.SilenceUsage = true
+				return fmt.Errorf("unknown resource type '%s'%s", args[0], suggestResourceType(args[0]))
+			}
+
+			DescribeResourceType(cmd.OutOrStdout(), schema)
+			return nil
+		},
+	})
+
 	return cmd
 }
+
+// DescribeResourceType prints the schema of the resource type in a human
+// readable form.
+func DescribeResourceType(w io.Writer, schema *resources.ResourceSchema) {
+	properties := "none, filters only match the resource name"
+	switch {
+	case schema.Properties != nil:
+		properties = strings.Join(schema.Properties, ", ")
+	case schema.Name == schema.CloudControl:
+		properties = "all properties returned by the Cloud Control API"
+	}
+
+	filter := "no"
+	if schema.Filter {
+		filter = "yes, some resources are skipped by aws-nuke itself"
+	}
+
+	featureFlags := "none"
+	if len(schema.FeatureFlags) > 0 {
+		featureFlags = strings.Join(schema.FeatureFlags, ", ")
+	}
+
+	cloudControl := "none"
+	if schema.CloudControl != "" {
+		cloudControl = schema.CloudControl
+	}
+
+	fmt.Fprintf(w, "Name:          %s\n", schema.Name)
+	fmt.Fprintf(w, "Properties:    %s\n", properties)
+	fmt.Fprintf(w, "Filter:        %s\n", filter)
+	fmt.Fprintf(w, "Feature Flags: %s\n", featureFlags)
+	fmt.Fprintf(w, "Cloud Control: %s\n", cloudControl)
+}
@@ -0,0 +1,24 @@
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/resources"
+)
+
+func TestDescribeResourceType(t *testing.T) {
+	buf := new(bytes.Buffer)
+	DescribeResourceType(buf, resources.GetSchema("RDSInstance"))
+
+	want := "" +
+		"Name:          RDSInstance\n" +
+		"Properties:    Identifier, DeletionProtection, AvailabilityZone, InstanceClass, Engine, EngineVersion, MultiAZ, PubliclyAccessible, InstanceCreateTime, tag:*\n" +
+		"Filter:        no\n" +
+		"Feature Flags: disable-deletion-protection.RDSInstance\n" +
+		"Cloud Control: none\n"
+
+	if buf.String() != want {
+		t.Errorf("Wrong description.\nWant:\n%s\nHave:\n%s", want, buf.String())
+	}
+}
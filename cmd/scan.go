@@ -2,8 +2,11 @@ package cmd
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"runtime/debug"
+	"sync"
+	"time"
 
 	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
 	"github.com/rebuy-de/aws-nuke/v2/pkg/util"
@@ -12,38 +15,119 @@ import (
 	"golang.org/x/sync/semaphore"
 )
 
+// ScannerParallelQueries is the default number of listers, which run at the
+// same time across all regions.
 const ScannerParallelQueries = 16
 
-func Scan(region *Region, resourceTypes []string) <-chan *Item {
+// A ScanTarget is a region together with the resource types to list in it.
+type ScanTarget struct {
+	Region        *Region
+	ResourceTypes []string
+}
+
+// Scan lists the resource types of all targets. The regions are scanned
+// concurrently, but at most parallelism listers run at the same time. The
+// items are sent in the order of the targets and their resource types,
+// regardless of which lister finishes first, so the output is deterministic.
+// It stops starting new listers, when the context gets cancelled, but the
+// returned channel is still closed only after the running ones are finished.
+// A positive timeout limits the time a single resource type may take to be
+// listed.
+func Scan(ctx context.Context, targets []ScanTarget, parallelism int, timeout time.Duration) <-chan *Item {
+	if parallelism < 1 {
+		parallelism = ScannerParallelQueries
+	}
+
 	s := &scanner{
-		items:     make(chan *Item, 100),
-		semaphore: semaphore.NewWeighted(ScannerParallelQueries),
+		items:       make(chan *Item, 100),
+		semaphore:   semaphore.NewWeighted(int64(parallelism)),
+		parallelism: int64(parallelism),
+		timeout:     timeout,
 	}
-	go s.run(region, resourceTypes)
+	go s.run(ctx, targets)
 
 	return s.items
 }
 
 type scanner struct {
-	items     chan *Item
-	semaphore *semaphore.Weighted
+	items       chan *Item
+	semaphore   *semaphore.Weighted
+	parallelism int64
+	timeout     time.Duration
+
+	// results buffers the items of each lister until all listers before it
+	// are done. next is the index of the first lister, whose items are not
+	// sent yet.
+	mu      sync.Mutex
+	results [][]*Item
+	done    []bool
+	next    int
 }
 
-func (s *scanner) run(region *Region, resourceTypes []string) {
-	ctx := context.Background()
+func (s *scanner) run(ctx context.Context, targets []ScanTarget) {
+	type job struct {
+		region       *Region
+		resourceType string
+	}
 
-	for _, resourceType := range resourceTypes {
-		s.semaphore.Acquire(ctx, 1)
-		go s.list(region, resourceType)
+	jobs := []job{}
+	for _, target := range targets {
+		for _, resourceType := range target.ResourceTypes {
+			jobs = append(jobs, job{region: target.Region, resourceType: resourceType})
+		}
 	}
 
-	// Wait for all routines to finish.
-	s.semaphore.Acquire(ctx, ScannerParallelQueries)
+	s.results = make([][]*Item, len(jobs))
+	s.done = make([]bool, len(jobs))
+
+	for i, job := range jobs {
+		err := s.semaphore.Acquire(ctx, 1)
+		if err != nil {
+			log.Debugf("stopped scanning %s: %v", job.region.Name, err)
+
+			// Listers which never started must not hold back the items
+			// of the running ones.
+			for j := i; j < len(jobs); j++ {
+				s.finish(j, nil)
+			}
+			break
+		}
+		go s.list(ctx, i, job.region, job.resourceType)
+	}
+
+	// Wait for all routines to finish. This must not be cancelled, since
+	// the channel cannot be closed while listers are still sending.
+	s.semaphore.Acquire(context.Background(), s.parallelism)
 
 	close(s.items)
 }
 
-func (s *scanner) list(region *Region, resourceType string) {
+// finish stores the items of a lister and sends all items, which are not
+// held back by a lister before them anymore.
+func (s *scanner) finish(index int, items []*Item) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.results[index] = items
+	s.done[index] = true
+
+	for s.next < len(s.done) && s.done[s.next] {
+		for _, item := range s.results[s.next] {
+			s.items <- item
+		}
+		s.results[s.next] = nil
+		s.next++
+	}
+}
+
+func (s *scanner) list(ctx context.Context, index int, region *Region, resourceType string) {
+	defer s.semaphore.Release(1)
+
+	var items []*Item
+	defer func() {
+		s.finish(index, items)
+	}()
+
 	defer func() {
 		if r := recover(); r != nil {
 			err := fmt.Errorf("%v\n\n%s", r.(error), string(debug.Stack()))
@@ -51,38 +135,57 @@ func (s *scanner) list(region *Region, resourceType string) {
 			log.Errorf("Listing %s failed:\n%s", resourceType, dump)
 		}
 	}()
-	defer s.semaphore.Release(1)
 
-	lister := resources.GetLister(resourceType)
+	items = s.listItems(ctx, region, resourceType)
+}
+
+func (s *scanner) listItems(ctx context.Context, region *Region, resourceType string) []*Item {
+	ctx, cancel := WithTimeout(ctx, s.timeout)
+	defer cancel()
+
+	lister := resources.GetContextLister(resourceType)
 	var rs []resources.Resource
 	sess, err := region.Session(resourceType)
 	if err == nil {
-		rs, err = lister(sess)
+		rs, err = lister(ctx, sess)
 	}
 	if err != nil {
+		if errors.Is(err, context.DeadlineExceeded) {
+			log.Errorf("Listing %s failed: timed out after %s", resourceType, s.timeout)
+			return nil
+		}
+
+		if errors.Is(err, context.Canceled) {
+			log.Debugf("stopped listing %s: %v", resourceType, err)
+			return nil
+		}
+
 		_, ok := err.(awsutil.ErrSkipRequest)
 		if ok {
 			log.Debugf("skipping request: %v", err)
-			return
+			return nil
 		}
 
 		_, ok = err.(awsutil.ErrUnknownEndpoint)
 		if ok {
 			log.Warnf("skipping request: %v", err)
-			return
+			return nil
 		}
 
 		dump := util.Indent(fmt.Sprintf("%v", err), "    ")
 		log.Errorf("Listing %s failed:\n%s", resourceType, dump)
-		return
+		return nil
 	}
 
+	items := []*Item{}
 	for _, r := range rs {
-		s.items <- &Item{
+		items = append(items, &Item{
 			Region:   region,
 			Resource: r,
 			State:    ItemStateNew,
 			Type:     resourceType,
-		}
+		})
 	}
+
+	return items
 }
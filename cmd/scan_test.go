@@ -0,0 +1,91 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+)
+
+func TestScanOrder(t *testing.T) {
+	server := awstest.NewServer(t)
+	server.Handle("ListQueues", awstest.JSON(`{"QueueUrls": ["https://sqs/queue-a", "https://sqs/queue-b"]}`))
+	server.Handle("DescribeClusters", awstest.XML(`<DescribeClustersResponse><DescribeClustersResult>
+		<Clusters><Cluster><ClusterIdentifier>alpha</ClusterIdentifier></Cluster></Clusters>
+	</DescribeClustersResult></DescribeClustersResponse>`))
+
+	creds := server.Credentials("sqs", "redshift")
+	sessionFactory := func(regionName, svcType string) (*session.Session, error) {
+		return creds.NewSession(awstest.Region, svcType)
+	}
+	typeResolver := func(regionName, resourceType string) string {
+		if resourceType == "SQSQueue" {
+			return "sqs"
+		}
+		return "redshift"
+	}
+
+	targets := []ScanTarget{}
+	for _, name := range []string{"us-east-1", "eu-west-1", "ap-south-1", "eu-central-1"} {
+		targets = append(targets, ScanTarget{
+			Region:        NewRegion(name, typeResolver, sessionFactory),
+			ResourceTypes: []string{"SQSQueue", "RedshiftCluster"},
+		})
+	}
+
+	want := []string{}
+	for _, target := range targets {
+		want = append(want,
+			target.Region.Name+" - SQSQueue - https://sqs/queue-a",
+			target.Region.Name+" - SQSQueue - https://sqs/queue-b",
+			target.Region.Name+" - RedshiftCluster - alpha")
+	}
+
+	for _, parallelism := range []int{1, 3, 16} {
+		t.Run(fmt.Sprint(parallelism), func(t *testing.T) {
+			have := []string{}
+			for item := range Scan(context.Background(), targets, parallelism, 0) {
+				have = append(have, fmt.Sprintf("%s - %s - %s", item.Region.Name, item.Type, item.Resource))
+			}
+
+			if !reflect.DeepEqual(want, have) {
+				t.Errorf("Wrong items.\nWant: %#v\nHave: %#v", want, have)
+			}
+		})
+	}
+}
+
+func TestScannerFinishOutOfOrder(t *testing.T) {
+	region := &Region{Name: "eu-west-1"}
+	item := func(resourceType string) *Item {
+		return &Item{Region: region, Type: resourceType}
+	}
+
+	s := &scanner{
+		items:   make(chan *Item, 10),
+		results: make([][]*Item, 3),
+		done:    make([]bool, 3),
+	}
+
+	s.finish(2, []*Item{item("C")})
+	s.finish(1, nil)
+	if len(s.items) != 0 {
+		t.Fatalf("Expected items to be held back until the first lister is done")
+	}
+
+	s.finish(0, []*Item{item("A1"), item("A2")})
+	close(s.items)
+
+	have := []string{}
+	for item := range s.items {
+		have = append(have, item.Type)
+	}
+
+	want := []string{"A1", "A2", "C"}
+	if !reflect.DeepEqual(want, have) {
+		t.Errorf("Wrong order.\nWant: %#v\nHave: %#v", want, have)
+	}
+}
@@ -0,0 +1,66 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+)
+
+// SignalContext returns a context which is cancelled on the first SIGINT or
+// SIGTERM, so running removals can finish and a final report gets printed. A
+// second signal terminates the process immediately.
+func SignalContext(parent context.Context) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(parent)
+
+	signals := make(chan os.Signal, 2)
+	signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-signals:
+		case <-ctx.Done():
+			signal.Stop(signals)
+			return
+		}
+
+		fmt.Fprintln(os.Stderr, "\nInterrupted. Waiting for running requests to finish. "+
+			"Interrupt again to exit immediately.")
+		cancel()
+
+		<-signals
+		fmt.Fprintln(os.Stderr, "\nInterrupted again. Exiting.")
+		os.Exit(130)
+	}()
+
+	return ctx, func() {
+		signal.Stop(signals)
+		cancel()
+	}
+}
+
+// WithTimeout is like context.WithTimeout, but a zero duration means that
+// there is no deadline at all.
+func WithTimeout(parent context.Context, d time.Duration) (context.Context, context.CancelFunc) {
+	if d <= 0 {
+		return context.WithCancel(parent)
+	}
+
+	return context.WithTimeout(parent, d)
+}
+
+// Sleep waits for the given duration, but returns early with an error, if the
+// context gets cancelled.
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
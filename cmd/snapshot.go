@@ -0,0 +1,101 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/v2/resources"
+)
+
+// Tags which are added to final snapshots. Snapshots with a retain-until
+// date in the future are not removed by later runs.
+const (
+	SnapshotTagRunID       = "aws-nuke:run-id"
+	SnapshotTagRetainUntil = "aws-nuke:retain-until"
+)
+
+// NewRunID returns an ID for the current run, which is used to name and tag
+// the final snapshots.
+func NewRunID() string {
+	return time.Now().UTC().Format("20060102T150405Z")
+}
+
+// TakeFinalSnapshot saves the data of the resource before it gets removed,
+// if this is enabled in the config. The snapshot is only created once, even
+// if the removal has to be retried. A retry after an interrupted wait only
+// waits for the existing snapshot.
+func (n *Nuke) TakeFinalSnapshot(ctx context.Context, item *Item) error {
+	fs := n.Config.Safety.FinalSnapshot
+	if !fs.Applies(item.Type) || item.SnapshotDone {
+		return nil
+	}
+
+	snapshotter, ok := item.Resource.(resources.Snapshotter)
+	if !ok {
+		if len(fs.ResourceTypes) == 0 {
+			return nil
+		}
+
+		// Explicitly listed resource types must not get lost silently.
+		return fmt.Errorf("%s does not support final snapshots", item.Type)
+	}
+
+	tags := map[string]string{SnapshotTagRunID: n.RunID}
+	if fs.Retention > 0 {
+		tags[SnapshotTagRetainUntil] = time.Now().Add(fs.Retention).UTC().Format(time.RFC3339)
+	}
+
+	opts := resources.SnapshotOptions{
+		Name:          "aws-nuke-" + n.RunID,
+		Tags:          tags,
+		BackupVault:   fs.BackupVault,
+		BackupRoleARN: fs.BackupRoleARN,
+	}
+
+	if item.Snapshot == "" {
+		arn, err := snapshotter.Snapshot(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("final snapshot failed: %w", err)
+		}
+
+		if arn == "" {
+			item.SnapshotDone = true
+			return nil
+		}
+
+		item.Snapshot = arn
+	}
+
+	err := snapshotter.WaitForSnapshot(ctx, item.Snapshot, opts)
+	if err != nil {
+		return fmt.Errorf("final snapshot %s failed: %w", item.Snapshot, err)
+	}
+
+	item.SnapshotDone = true
+	return nil
+}
+
+// RetainedUntil returns the date until which the resource is protected, if
+// it is a final snapshot of a previous run.
+func RetainedUntil(item *Item) (time.Time, bool) {
+	if !item.SupportsProperties(TagPropertyName(SnapshotTagRetainUntil)) {
+		return time.Time{}, false
+	}
+
+	value, err := item.GetProperty(TagPropertyName(SnapshotTagRetainUntil))
+	if err != nil || value == "" {
+		return time.Time{}, false
+	}
+
+	until, err := time.Parse(time.RFC3339, value)
+	if err != nil || !until.After(time.Now()) {
+		return time.Time{}, false
+	}
+
+	return until, true
+}
+
+func TagPropertyName(key string) string {
+	return "tag:" + key
+}
@@ -0,0 +1,166 @@
+package cmd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+	"github.com/rebuy-de/aws-nuke/v2/resources"
+)
+
+type testSnapshotResource struct {
+	snapshots []resources.SnapshotOptions
+	waits     []string
+	waitErr   error
+	removed   bool
+}
+
+func (r *testSnapshotResource) Remove() error {
+	r.removed = true
+	return nil
+}
+
+func (r *testSnapshotResource) Snapshot(ctx context.Context, opts resources.SnapshotOptions) (string, error) {
+	r.snapshots = append(r.snapshots, opts)
+	return "arn:aws:rds:eu-west-1:123456789012:snapshot:final", nil
+}
+
+func (r *testSnapshotResource) WaitForSnapshot(ctx context.Context, arn string, opts resources.SnapshotOptions) error {
+	r.waits = append(r.waits, arn)
+	return r.waitErr
+}
+
+func TestHandleRemoveFinalSnapshot(t *testing.T) {
+	n := &Nuke{
+		RunID: "20261018T120000Z",
+		Config: &config.Nuke{
+			Safety: config.Safety{
+				FinalSnapshot: config.FinalSnapshot{
+					Enabled:   true,
+					Retention: 24 * time.Hour,
+				},
+			},
+		},
+	}
+
+	resource := &testSnapshotResource{}
+	item := &Item{Type: "RDSInstance", State: ItemStateNew, Resource: resource}
+
+	n.HandleRemove(context.Background(), item)
+	n.HandleRemove(context.Background(), item)
+
+	if item.State != ItemStatePending {
+		t.Fatalf("Wrong item state. Want: %s. Have: %s (%s)", ItemStatePending, item.State, item.Reason)
+	}
+
+	if !resource.removed {
+		t.Fatalf("Resource was not removed.")
+	}
+
+	if len(resource.snapshots) != 1 {
+		t.Fatalf("Wrong number of snapshots. Want: 1. Have: %d", len(resource.snapshots))
+	}
+
+	if item.Snapshot != "arn:aws:rds:eu-west-1:123456789012:snapshot:final" {
+		t.Errorf("Wrong snapshot ARN: %s", item.Snapshot)
+	}
+
+	opts := resource.snapshots[0]
+	if opts.Tags[SnapshotTagRunID] != n.RunID {
+		t.Errorf("Wrong run ID tag: %s", opts.Tags[SnapshotTagRunID])
+	}
+
+	if _, ok := opts.Tags[SnapshotTagRetainUntil]; !ok {
+		t.Errorf("Missing retain-until tag.")
+	}
+}
+
+func TestHandleRemoveFinalSnapshotInterruptedWait(t *testing.T) {
+	n := &Nuke{
+		RunID: "20261018T120000Z",
+		Config: &config.Nuke{
+			Safety: config.Safety{
+				FinalSnapshot: config.FinalSnapshot{
+					Enabled: true,
+				},
+			},
+		},
+	}
+
+	resource := &testSnapshotResource{waitErr: context.DeadlineExceeded}
+	item := &Item{Type: "RDSInstance", State: ItemStateNew, Resource: resource}
+
+	n.HandleRemove(context.Background(), item)
+	if item.State != ItemStateFailed {
+		t.Fatalf("Wrong item state. Want: %s. Have: %s", ItemStateFailed, item.State)
+	}
+	if resource.removed {
+		t.Fatalf("Resource was removed without a finished snapshot.")
+	}
+
+	resource.waitErr = nil
+	n.HandleRemove(context.Background(), item)
+	if item.State != ItemStatePending {
+		t.Fatalf("Wrong item state. Want: %s. Have: %s (%s)", ItemStatePending, item.State, item.Reason)
+	}
+
+	if len(resource.snapshots) != 1 {
+		t.Errorf("Wrong number of snapshots. Want: 1. Have: %d", len(resource.snapshots))
+	}
+
+	want := []string{
+		"arn:aws:rds:eu-west-1:123456789012:snapshot:final",
+		"arn:aws:rds:eu-west-1:123456789012:snapshot:final",
+	}
+	if !reflect.DeepEqual(resource.waits, want) {
+		t.Errorf("Wrong waits. Want: %v. Have: %v", want, resource.waits)
+	}
+}
+
+func TestHandleRemoveFinalSnapshotUnsupported(t *testing.T) {
+	n := &Nuke{
+		Config: &config.Nuke{
+			Safety: config.Safety{
+				FinalSnapshot: config.FinalSnapshot{
+					Enabled:       true,
+					ResourceTypes: types.Collection{"S3Bucket"},
+				},
+			},
+		},
+	}
+
+	item := &Item{Type: "S3Bucket", State: ItemStateNew, Resource: &testResource{id: "foo"}}
+	n.HandleRemove(context.Background(), item)
+
+	if item.State != ItemStateFailed {
+		t.Fatalf("Wrong item state. Want: %s. Have: %s", ItemStateFailed, item.State)
+	}
+}
+
+func TestRetainedUntil(t *testing.T) {
+	cases := []struct {
+		value string
+		want  bool
+	}{
+		{time.Now().Add(time.Hour).UTC().Format(time.RFC3339), true},
+		{time.Now().Add(-time.Hour).UTC().Format(time.RFC3339), false},
+		{"garbage", false},
+		{"", false},
+	}
+
+	for _, tc := range cases {
+		key := SnapshotTagRetainUntil
+		properties := types.NewProperties()
+		if tc.value != "" {
+			properties.SetTag(&key, tc.value)
+		}
+
+		item := &Item{Type: "EC2Snapshot", Resource: &testPropertyResource{properties: properties}}
+		if _, have := RetainedUntil(item); have != tc.want {
+			t.Errorf("Wrong result for %q. Want: %t. Have: %t", tc.value, tc.want, have)
+		}
+	}
+}
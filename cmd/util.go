@@ -2,6 +2,7 @@ package cmd
 
 import (
 	"bufio"
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -9,18 +10,39 @@ import (
 	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
 )
 
-func Prompt(expect string) error {
-	fmt.Print("> ")
-	reader := bufio.NewReader(os.Stdin)
-	text, err := reader.ReadString('\n')
-	if err != nil {
-		return err
+func Prompt(ctx context.Context, expect string) error {
+	fmt.Fprint(Console, "> ")
+
+	type result struct {
+		text string
+		err  error
+	}
+
+	// Reading from stdin cannot be cancelled, so it happens in the background
+	// to still react on interrupts.
+	input := make(chan result, 1)
+	go func() {
+		reader := bufio.NewReader(os.Stdin)
+		text, err := reader.ReadString('\n')
+		input <- result{text: text, err: err}
+	}()
+
+	var text string
+	select {
+	case <-ctx.Done():
+		fmt.Fprintln(Console)
+		return fmt.Errorf("aborted")
+	case r := <-input:
+		if r.err != nil {
+			return r.err
+		}
+		text = r.text
 	}
 
 	if strings.TrimSpace(text) != expect {
 		return fmt.Errorf("aborted")
 	}
-	fmt.Println()
+	fmt.Fprintln(Console)
 
 	return nil
 }
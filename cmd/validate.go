@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+	"github.com/rebuy-de/aws-nuke/v2/resources"
+	"github.com/spf13/cobra"
+)
+
+type ConfigIssueLevel string
+
+const (
+	ConfigIssueError   ConfigIssueLevel = "error"
+	ConfigIssueWarning ConfigIssueLevel = "warning"
+)
+
+// A ConfigIssue is a problem in the config, which cannot be detected when
+// parsing the YAML, eg a typo in a resource type.
+type ConfigIssue struct {
+	Level   ConfigIssueLevel
+	Path    string
+	Message string
+}
+
+func (i ConfigIssue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Level, i.Path, i.Message)
+}
+
+type configValidator struct {
+	config *config.Nuke
+	issues []ConfigIssue
+}
+
+// ValidateConfig checks the config against the known resource types and
+// their properties. It does not need any access to AWS.
+func ValidateConfig(c *config.Nuke) []ConfigIssue {
+	v := &configValidator{config: c}
+
+	v.resourceTypes("resource-types", c.ResourceTypes)
+
+	for _, name := range sortedKeys(c.Presets) {
+		path := fmt.Sprintf("presets.%s", name)
+		v.filters(path+".filters", c.Presets[name].Filters)
+		v.regions(path+".regions", c.Presets[name].Regions)
+	}
+
+	for _, id := range sortedKeys(c.Accounts) {
+		account := c.Accounts[id]
+		path := fmt.Sprintf("accounts.%s", id)
+
+		for i, preset := range account.Presets {
+			if _, ok := c.Presets[preset]; !ok {
+				v.errorf(fmt.Sprintf("%s.presets[%d]", path, i), "preset '%s' is not defined", preset)
+			}
+		}
+
+		v.resourceTypes(path+".resource-types", account.ResourceTypes)
+		v.filters(path+".filters", account.Filters)
+		v.regions(path+".regions", account.Regions)
+	}
+
+	return v.issues
+}
+
+func (v *configValidator) errorf(path, format string, args ...interface{}) {
+	v.issues = append(v.issues, ConfigIssue{
+		Level:   ConfigIssueError,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *configValidator) warnf(path, format string, args ...interface{}) {
+	v.issues = append(v.issues, ConfigIssue{
+		Level:   ConfigIssueWarning,
+		Path:    path,
+		Message: fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *configValidator) regions(path string, regions map[string]config.RegionConfig) {
+	// Without keywords the scanned regions are known before the run.
+	var scanned types.Collection
+	if !v.config.HasRegionKeywords() {
+		scanned, _ = v.config.ResolveRegions(nil)
+	}
+
+	for _, region := range sortedKeys(regions) {
+		regionPath := path + "." + region
+
+		if scanned != nil && !scanned.Contains(region) {
+			v.warnf(regionPath, "region '%s' is not scanned, since it is not in the regions of the config", region)
+		}
+
+		v.resourceTypes(regionPath+".resource-types", regions[region].ResourceTypes)
+		v.filters(regionPath+".filters", regions[region].Filters)
+	}
+}
+
+func (v *configValidator) resourceTypes(path string, rt config.ResourceTypes) {
+	v.resourceTypeNames(path+".targets", rt.Targets)
+	v.resourceTypeNames(path+".excludes", rt.Excludes)
+
+	mapping := resources.GetCloudControlMapping()
+	for i, name := range rt.CloudControl {
+		itemPath := fmt.Sprintf("%s.cloud-control[%d]", path, i)
+		if !strings.HasPrefix(name, "AWS::") {
+			v.errorf(itemPath, "'%s' is not a Cloud Control type name, eg AWS::EC2::VPC", name)
+			continue
+		}
+
+		if _, ok := mapping[name]; !ok {
+			v.warnf(itemPath, "'%s' does not replace a native resource type", name)
+		}
+	}
+}
+
+func (v *configValidator) resourceTypeNames(path string, names types.Collection) {
+	for i, name := range names {
+		if !isKnownResourceType(name) {
+			v.errorf(fmt.Sprintf("%s[%d]", path, i), "unknown resource type '%s'%s", name, suggestResourceType(name))
+		}
+	}
+}
+
+func (v *configValidator) filters(path string, filters config.Filters) {
+	for _, resourceType := range sortedKeys(filters) {
+		typePath := path + "." + resourceType
+
+		if resourceType != config.GlobalFiltersKey && !isKnownResourceType(resourceType) {
+			v.errorf(typePath, "unknown resource type '%s'%s", resourceType, suggestResourceType(resourceType))
+			continue
+		}
+
+		for i, filter := range filters[resourceType] {
+			v.filter(fmt.Sprintf("%s[%d]", typePath, i), resourceType, filter)
+		}
+	}
+}
+
+func (v *configValidator) filter(path, resourceType string, filter config.Filter) {
+	if filter.IsComposite() {
+		for i, nested := range filter.All {
+			v.filter(fmt.Sprintf("%s.all[%d]", path, i), resourceType, nested)
+		}
+		for i, nested := range filter.Any {
+			v.filter(fmt.Sprintf("%s.any[%d]", path, i), resourceType, nested)
+		}
+		if filter.Not != nil {
+			v.filter(path+".not", resourceType, *filter.Not)
+		}
+		return
+	}
+
+	if !filter.Type.IsKnown() {
+		v.errorf(path, "unknown filter type '%s'", filter.Type)
+		return
+	}
+
+	switch filter.Type {
+	case config.FilterTypeRegex:
+		if _, err := regexp.Compile(filter.Value); err != nil {
+			v.errorf(path, "invalid regular expression: %v", err)
+		}
+
+	case config.FilterTypeDateOlderThan, config.FilterTypeDateNewerThan:
+		if _, err := time.ParseDuration(filter.Value); err != nil {
+			v.errorf(path, "invalid duration: %v", err)
+		}
+	}
+
+	if filter.Property == "" || filter.Property == CreatedAtProperty || resourceType == config.GlobalFiltersKey {
+		return
+	}
+
+	schema := resources.GetSchema(resourceType)
+	if schema != nil && schema.Properties == nil && schema.CloudControl != resourceType {
+		v.errorf(path, "resource type %s does not support properties, filters only match the resource name",
+			resourceType)
+		return
+	}
+
+	if !resources.HasProperty(resourceType, filter.Property) {
+		known, _ := resources.GetProperties(resourceType)
+		v.errorf(path, "resource type %s does not have the property '%s' (known: %s)",
+			resourceType, filter.Property, strings.Join(known, ", "))
+	}
+}
+
+func isKnownResourceType(name string) bool {
+	if strings.HasPrefix(name, "AWS::") {
+		return true
+	}
+
+	return resources.GetLister(name) != nil
+}
+
+// suggestResourceType returns a hint with a resource type which only differs
+// in case from the given one, since this is the most common typo.
+func suggestResourceType(name string) string {
+	for _, known := range resources.GetListerNames() {
+		if strings.EqualFold(known, name) {
+			return fmt.Sprintf(" (did you mean '%s'?)", known)
+		}
+	}
+
+	return ""
+}
+
+func sortedKeys[T any](m map[string]T) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func NewConfigCommand(params *NukeParameters) *cobra.Command {
+	command := &cobra.Command{
+		Use:   "config",
+		Short: "works with the nuke config",
+	}
+
+	command.AddCommand(&cobra.Command{
+		Use:   "validate",
+		Short: "checks the config for unknown resource types, properties and invalid filters",
+		Long: `Checks the config for unknown resource types, properties and invalid filters. ` +
+			`It does not need any AWS credentials.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if strings.TrimSpace(params.ConfigPath) == "" {
+				return fmt.Errorf("You have to specify the --config flag.\n")
+			}
+
+			cmd.SilenceUsage = true
+
+			c, err := config.Load(params.ConfigPath)
+			if err != nil {
+				return fmt.Errorf("failed to parse config file %s: %w", params.ConfigPath, err)
+			}
+
+			errors := 0
+			for _, issue := range ValidateConfig(c) {
+				fmt.Fprintln(cmd.OutOrStdout(), issue.String())
+				if issue.Level == ConfigIssueError {
+					errors++
+				}
+			}
+
+			if errors > 0 {
+				return fmt.Errorf("the config %s has %d error(s)", params.ConfigPath, errors)
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "The config %s is valid.\n", params.ConfigPath)
+			return nil
+		},
+	})
+
+	return command
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+)
+
+func TestValidateConfig(t *testing.T) {
+	cfg := &config.Nuke{
+		Regions: []string{"global", "eu-central-1"},
+		ResourceTypes: config.ResourceTypes{
+			Targets:      types.Collection{"S3Bucket", "S3Buckets"},
+			Excludes:     types.Collection{"iamrole"},
+			CloudControl: types.Collection{"AWS::Athena::NamedQuery", "AWS::Foo::Bar", "EC2VPC"},
+		},
+		Presets: map[string]config.PresetDefinitions{
+			"common": {
+				Filters: config.Filters{
+					"IAMRole": {
+						config.NewExactFilter("OrganizationAccountAccessRole"),
+						{Property: "tag:Owner", Type: config.FilterTypeGlob, Value: "*"},
+					},
+				},
+			},
+		},
+		Accounts: map[string]config.Account{
+			"123456789012": {
+				Presets: []string{"common", "missing"},
+				Regions: map[string]config.RegionConfig{
+					"eu-central-1": {
+						ResourceTypes: config.ResourceTypes{Excludes: types.Collection{"EC2Vpc"}},
+					},
+					"eu-west-1": {
+						Filters: config.Filters{"EC2VPC": {{Property: "IsDefault", Value: "true"}}},
+					},
+				},
+				Filters: config.Filters{
+					config.GlobalFiltersKey: {
+						{Property: "tag:Environment", Value: "prod"},
+					},
+					"IAMUsr": {
+						config.NewExactFilter("admin"),
+					},
+					"IAMUser": {
+						{Property: "Nmae", Value: "admin"},
+						{Property: "CreatedAt", Type: config.FilterTypeDateOlderThan, Value: "24h"},
+						{Property: "Name", Type: config.FilterTypeRegex, Value: "admin("},
+					},
+					"EC2Volume": {
+						{Any: []config.Filter{
+							{Property: "tag:Name", Value: "keep"},
+							{Not: &config.Filter{Property: "State", Type: "prefix", Value: "in-use"}},
+						}},
+					},
+					"LaunchConfiguration": {
+						{Property: "Name", Value: "default"},
+					},
+					"RDSInstance": {
+						{Property: "InstanceCreateTime", Type: config.FilterTypeDateOlderThan, Value: "1 day"},
+					},
+				},
+			},
+		},
+	}
+
+	want := []string{
+		"error: resource-types.targets[1]: unknown resource type 'S3Buckets'",
+		"error: resource-types.excludes[0]: unknown resource type 'iamrole' (did you mean 'IAMRole'?)",
+		"warning: resource-types.cloud-control[1]: 'AWS::Foo::Bar' does not replace a native resource type",
+		"error: resource-types.cloud-control[2]: 'EC2VPC' is not a Cloud Control type name, eg AWS::EC2::VPC",
+		"error: accounts.123456789012.presets[1]: preset 'missing' is not defined",
+		"error: accounts.123456789012.filters.EC2Volume[0].any[1].not: unknown filter type 'prefix'",
+		"error: accounts.123456789012.filters.IAMUser[0]: resource type IAMUser does not have the property 'Nmae' (known: Name, CreateDate, PasswordLastUsed, tag:*)",
+		"error: accounts.123456789012.filters.IAMUser[2]: invalid regular expression: error parsing regexp: missing closing ): `admin(`",
+		"error: accounts.123456789012.filters.IAMUsr: unknown resource type 'IAMUsr'",
+		"error: accounts.123456789012.filters.LaunchConfiguration[0]: resource type LaunchConfiguration does not support properties, filters only match the resource name",
+		"error: accounts.123456789012.filters.RDSInstance[0]: invalid duration: time: unknown unit \" day\" in duration \"1 day\"",
+		"error: accounts.123456789012.regions.eu-central-1.resource-types.excludes[0]: unknown resource type 'EC2Vpc' (did you mean 'EC2VPC'?)",
+		"warning: accounts.123456789012.regions.eu-west-1: region 'eu-west-1' is not scanned, since it is not in the regions of the config",
+	}
+
+	have := []string{}
+	for _, issue := range ValidateConfig(cfg) {
+		have = append(have, issue.String())
+	}
+
+	if !reflect.DeepEqual(want, have) {
+		t.Errorf("Wrong issues.\nWant: %#v\nHave: %#v", want, have)
+	}
+}
+
+func TestValidateConfigValid(t *testing.T) {
+	cfg, err := config.Load("../pkg/config/test-fixtures/example.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issues := ValidateConfig(cfg)
+	if len(issues) != 0 {
+		t.Errorf("Expected no issues, got %v", issues)
+	}
+}
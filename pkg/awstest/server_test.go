@@ -0,0 +1,57 @@
+package awstest
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestOperation(t *testing.T) {
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		header http.Header
+		body   string
+		want   string
+	}{
+		{
+			name:   "JSON",
+			method: "POST",
+			path:   "/",
+			header: http.Header{"X-Amz-Target": {"DynamoDB_20120810.ListTables"}},
+			body:   `{}`,
+			want:   "ListTables",
+		},
+		{
+			name:   "Query",
+			method: "POST",
+			path:   "/",
+			header: http.Header{"Content-Type": {"application/x-www-form-urlencoded; charset=utf-8"}},
+			body:   "Action=DescribeVolumes&Version=2016-11-15",
+			want:   "DescribeVolumes",
+		},
+		{
+			name:   "REST",
+			method: "GET",
+			path:   "/2015-02-01/file-systems",
+			header: http.Header{},
+			want:   "GET /2015-02-01/file-systems",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r, err := http.NewRequest(tc.method, "http://localhost"+tc.path, strings.NewReader(tc.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			r.Header = tc.header
+
+			have := Operation(r, []byte(tc.body))
+			if have != tc.want {
+				t.Errorf("Wrong operation. Want: %s. Have: %s", tc.want, have)
+			}
+		})
+	}
+}
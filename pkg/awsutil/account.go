@@ -3,6 +3,7 @@ package awsutil
 import (
 	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/pkg/errors"
@@ -14,6 +15,9 @@ type Account struct {
 
 	id      string
 	aliases []string
+
+	callerARN    string
+	callerUserID string
 }
 
 func NewAccount(creds Credentials, endpoints config.CustomEndpoints) (*Account, error) {
@@ -65,6 +69,8 @@ func NewAccount(creds Credentials, endpoints config.CustomEndpoints) (*Account,
 
 	account.id = *identityOutput.Account
 	account.aliases = aliases
+	account.callerARN = aws.StringValue(identityOutput.Arn)
+	account.callerUserID = aws.StringValue(identityOutput.UserId)
 
 	return &account, nil
 }
@@ -73,6 +79,16 @@ func (a *Account) ID() string {
 	return a.id
 }
 
+// CallerARN returns the ARN of the identity which is used to access the
+// account. It is empty for custom endpoints without STS.
+func (a *Account) CallerARN() string {
+	return a.callerARN
+}
+
+func (a *Account) CallerUserID() string {
+	return a.callerUserID
+}
+
 func (a *Account) Alias() string {
 	return a.aliases[0]
 }
@@ -0,0 +1,279 @@
+package awsutil
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	reUnsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9-]+`)
+
+	// Responses of STS and SSO contain credentials, which must not end up
+	// in a cassette.
+	reSecretXMLElement = regexp.MustCompile(`<(SecretAccessKey|SessionToken)>[^<]*</`)
+	reSecretJSONField  = regexp.MustCompile(`"(secretAccessKey|SecretAccessKey|sessionToken|SessionToken)"(\s*):(\s*)"[^"]*"`)
+)
+
+// HideSecureBody removes credentials from response bodies.
+func HideSecureBody(body []byte) []byte {
+	body = reSecretXMLElement.ReplaceAll(body, []byte("<$1>&lt;hidden&gt;</"))
+	body = reSecretJSONField.ReplaceAll(body, []byte(`"$1"$2:$3"<hidden>"`))
+	return body
+}
+
+// Interaction is a single recorded AWS request with its response.
+type Interaction struct {
+	Service   string `json:"service"`
+	Region    string `json:"region"`
+	Operation string `json:"operation"`
+
+	// Request is the HTTP dump of the request without secret headers.
+	Request     string `json:"request"`
+	RequestBody string `json:"request-body,omitempty"`
+
+	Response RecordedResponse `json:"response"`
+}
+
+type RecordedResponse struct {
+	Status int         `json:"status"`
+	Header http.Header `json:"header,omitempty"`
+	Body   string      `json:"body,omitempty"`
+}
+
+func (i *Interaction) key() string {
+	return i.Service + "/" + i.Region + "/" + i.Operation
+}
+
+// A Cassette records the AWS traffic into a directory or replays it from
+// there without network access. Every interaction is stored in its own file,
+// so cassettes can be attached to bug reports and reviewed easily.
+type Cassette struct {
+	dir    string
+	replay bool
+
+	mu           sync.Mutex
+	seq          int
+	pending      map[*request.Request]string
+	interactions map[string][]*Interaction
+}
+
+// NewRecorder creates a cassette, which records all AWS traffic into the
+// directory.
+func NewRecorder(dir string) (*Cassette, error) {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Cassette{
+		dir:     dir,
+		pending: map[*request.Request]string{},
+	}, nil
+}
+
+// LoadCassette reads a recorded cassette for replaying it.
+func LoadCassette(dir string) (*Cassette, error) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(paths) == 0 {
+		return nil, fmt.Errorf("no recorded interactions found in %s", dir)
+	}
+
+	// The file names start with a sequence number, so sorting restores the
+	// recorded order.
+	sort.Strings(paths)
+
+	c := &Cassette{
+		dir:          dir,
+		replay:       true,
+		interactions: map[string][]*Interaction{},
+	}
+
+	for _, path := range paths {
+		raw, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		interaction := new(Interaction)
+		err = json.Unmarshal(raw, interaction)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse recorded interaction %s: %w", path, err)
+		}
+
+		c.interactions[interaction.key()] = append(c.interactions[interaction.key()], interaction)
+	}
+
+	return c, nil
+}
+
+func (c *Cassette) Replaying() bool {
+	return c != nil && c.replay
+}
+
+// Install hooks the cassette into the session.
+func (c *Cassette) Install(sess *session.Session) {
+	if c.replay {
+		sess.Handlers.Send.Clear()
+		sess.Handlers.Send.PushBack(c.replayHandler)
+		return
+	}
+
+	sess.Handlers.Send.PushFront(c.recordRequestHandler)
+	sess.Handlers.Send.PushBack(c.recordResponseHandler)
+}
+
+func (c *Cassette) recordRequestHandler(r *request.Request) {
+	dump, err := httputil.DumpRequest(r.HTTPRequest, true)
+	if err != nil {
+		log.Warnf("failed to record AWS request: %v", err)
+		return
+	}
+
+	c.mu.Lock()
+	c.pending[r] = string(HideSecureHeaders(dump))
+	c.mu.Unlock()
+}
+
+func (c *Cassette) recordResponseHandler(r *request.Request) {
+	c.mu.Lock()
+	dump, ok := c.pending[r]
+	delete(c.pending, r)
+	c.mu.Unlock()
+
+	if !ok || r.HTTPResponse == nil {
+		return
+	}
+
+	body, err := io.ReadAll(r.HTTPResponse.Body)
+	r.HTTPResponse.Body.Close()
+	r.HTTPResponse.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		log.Warnf("failed to record AWS response: %v", err)
+		return
+	}
+
+	interaction := newInteraction(r, dump)
+	interaction.Response = RecordedResponse{
+		Status: r.HTTPResponse.StatusCode,
+		Header: r.HTTPResponse.Header,
+		Body:   string(HideSecureBody(body)),
+	}
+
+	err = c.write(interaction)
+	if err != nil {
+		log.Warnf("failed to record AWS interaction: %v", err)
+	}
+}
+
+func (c *Cassette) write(interaction *Interaction) error {
+	raw, err := json.MarshalIndent(interaction, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	c.seq++
+	seq := c.seq
+	c.mu.Unlock()
+
+	name := fmt.Sprintf("%06d-%s-%s-%s.json", seq,
+		reUnsafeFileChars.ReplaceAllString(interaction.Service, "-"),
+		reUnsafeFileChars.ReplaceAllString(interaction.Region, "-"),
+		reUnsafeFileChars.ReplaceAllString(interaction.Operation, "-"))
+
+	return os.WriteFile(filepath.Join(c.dir, name), append(raw, '\n'), 0600)
+}
+
+func (c *Cassette) replayHandler(r *request.Request) {
+	dump, err := httputil.DumpRequest(r.HTTPRequest, true)
+	if err != nil {
+		r.Error = err
+		return
+	}
+
+	wanted := newInteraction(r, string(dump))
+	interaction := c.next(wanted)
+	if interaction == nil {
+		r.Error = awserr.New("ReplayMissing", fmt.Sprintf(
+			"no recorded response for %s in %s", wanted.key(), wanted.Region), nil)
+		r.Retryable = aws.Bool(false)
+		return
+	}
+
+	r.HTTPResponse = &http.Response{
+		StatusCode: interaction.Response.Status,
+		Status:     http.StatusText(interaction.Response.Status),
+		Header:     interaction.Response.Header,
+		Body:       io.NopCloser(strings.NewReader(interaction.Response.Body)),
+		Request:    r.HTTPRequest,
+	}
+	if r.HTTPResponse.Header == nil {
+		r.HTTPResponse.Header = http.Header{}
+	}
+}
+
+// next returns the next recorded interaction for the same operation. Since
+// the requests are sent in parallel, the order between different operations
+// differs from the recording. Interactions with the same request body are
+// preferred, so paginated requests get the right page.
+func (c *Cassette) next(wanted *Interaction) *Interaction {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	candidates := c.interactions[wanted.key()]
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	index := 0
+	for i, candidate := range candidates {
+		if candidate.RequestBody == wanted.RequestBody {
+			index = i
+			break
+		}
+	}
+
+	// The last interaction is kept, since the same request might be sent
+	// more often than during the recording, eg when waiting for removals.
+	interaction := candidates[index]
+	if len(candidates) > 1 {
+		c.interactions[wanted.key()] = append(candidates[:index:index], candidates[index+1:]...)
+	}
+
+	return interaction
+}
+
+func newInteraction(r *request.Request, dump string) *Interaction {
+	body := ""
+	if parts := strings.SplitN(dump, "\r\n\r\n", 2); len(parts) == 2 {
+		body = parts[1]
+	}
+
+	return &Interaction{
+		Service:     r.ClientInfo.ServiceName,
+		Region:      aws.StringValue(r.Config.Region),
+		Operation:   r.Operation.Name,
+		Request:     dump,
+		RequestBody: body,
+	}
+}
@@ -0,0 +1,95 @@
+package awsutil_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
+)
+
+const testAssumeRoleResponse = `<AssumeRoleResponse><AssumeRoleResult>
+	<Credentials>
+		<AccessKeyId>ASIAEXAMPLE</AccessKeyId>
+		<SecretAccessKey>very-secret</SecretAccessKey>
+		<SessionToken>also-secret</SessionToken>
+		<Expiration>2030-01-01T00:00:00Z</Expiration>
+	</Credentials>
+</AssumeRoleResult></AssumeRoleResponse>`
+
+func TestCassetteRecordAndReplay(t *testing.T) {
+	dir := t.TempDir()
+
+	server := awstest.NewServer(t)
+	server.Handle("AssumeRole", awstest.XML(testAssumeRoleResponse))
+
+	recorder, err := awsutil.NewRecorder(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds := server.Credentials("sts")
+	creds.Cassette = recorder
+
+	recorded := assumeRole(t, creds)
+
+	paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(paths) != 1 {
+		t.Fatalf("Wrong number of recorded interactions. Want: 1. Have: %d", len(paths))
+	}
+
+	raw, err := os.ReadFile(paths[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, secret := range []string{"very-secret", "also-secret", creds.SecretAccessKey} {
+		if strings.Contains(string(raw), secret) {
+			t.Errorf("Recording contains secret %q:\n%s", secret, string(raw))
+		}
+	}
+
+	cassette, err := awsutil.LoadCassette(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	creds = server.Credentials("sts")
+	creds.Cassette = cassette
+	replayed := assumeRole(t, creds)
+
+	if recorded != replayed {
+		t.Errorf("Wrong replayed response. Want: %s. Have: %s", recorded, replayed)
+	}
+
+	if len(server.Requests("AssumeRole")) != 1 {
+		t.Errorf("The replay sent requests to the server.")
+	}
+}
+
+func assumeRole(t *testing.T, creds *awsutil.Credentials) string {
+	t.Helper()
+
+	sess, err := creds.NewSession(awstest.Region, "sts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := sts.New(sess).AssumeRole(&sts.AssumeRoleInput{
+		RoleArn:         aws.String("arn:aws:iam::123456789012:role/test"),
+		RoleSessionName: aws.String("test"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return aws.StringValue(resp.Credentials.AccessKeyId)
+}
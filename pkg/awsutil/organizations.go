@@ -0,0 +1,129 @@
+package awsutil
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/organizations"
+	"github.com/pkg/errors"
+)
+
+// ListOrganizationalUnitAccounts returns the IDs of all active accounts in
+// the organizational unit, including the ones in nested units.
+func (c *Credentials) ListOrganizationalUnitAccounts(ouID string) ([]string, error) {
+	sess, err := c.NewSession(GlobalRegionID, "")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create global session in %s", GlobalRegionID)
+	}
+
+	svc := organizations.New(sess)
+
+	accountIDs := []string{}
+	parents := []string{ouID}
+	for len(parents) > 0 {
+		parent := parents[0]
+		parents = parents[1:]
+
+		err := svc.ListAccountsForParentPages(&organizations.ListAccountsForParentInput{
+			ParentId: aws.String(parent),
+		}, func(page *organizations.ListAccountsForParentOutput, lastPage bool) bool {
+			for _, account := range page.Accounts {
+				if aws.StringValue(account.Status) == organizations.AccountStatusActive {
+					accountIDs = append(accountIDs, aws.StringValue(account.Id))
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list accounts of %s", parent)
+		}
+
+		err = svc.ListOrganizationalUnitsForParentPages(&organizations.ListOrganizationalUnitsForParentInput{
+			ParentId: aws.String(parent),
+		}, func(page *organizations.ListOrganizationalUnitsForParentOutput, lastPage bool) bool {
+			for _, ou := range page.OrganizationalUnits {
+				parents = append(parents, aws.StringValue(ou.Id))
+			}
+			return true
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list organizational units of %s", parent)
+		}
+	}
+
+	return accountIDs, nil
+}
+
+// OrganizationAccount describes the position of an account in AWS
+// Organizations.
+type OrganizationAccount struct {
+	ID     string
+	Status string
+
+	// ParentIDs contains the IDs of all organizational units above the
+	// account, starting with the direct parent and ending with the root.
+	ParentIDs []string
+
+	Tags map[string]string
+}
+
+// DescribeOrganizationAccount looks up the account in AWS Organizations.
+// This only works with credentials of the management account or of a
+// delegated administrator.
+func (c *Credentials) DescribeOrganizationAccount(accountID string) (*OrganizationAccount, error) {
+	sess, err := c.NewSession(GlobalRegionID, "organizations")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create global session in %s", GlobalRegionID)
+	}
+
+	svc := organizations.New(sess)
+
+	described, err := svc.DescribeAccount(&organizations.DescribeAccountInput{
+		AccountId: aws.String(accountID),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to describe account %s", accountID)
+	}
+
+	account := &OrganizationAccount{
+		ID:        accountID,
+		Status:    aws.StringValue(described.Account.Status),
+		ParentIDs: []string{},
+		Tags:      map[string]string{},
+	}
+
+	child := accountID
+	for {
+		parents, err := svc.ListParents(&organizations.ListParentsInput{
+			ChildId: aws.String(child),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to list parents of %s", child)
+		}
+
+		// Accounts and organizational units have exactly one parent.
+		if len(parents.Parents) == 0 {
+			break
+		}
+
+		parent := parents.Parents[0]
+		account.ParentIDs = append(account.ParentIDs, aws.StringValue(parent.Id))
+		if aws.StringValue(parent.Type) == organizations.ParentTypeRoot {
+			break
+		}
+
+		child = aws.StringValue(parent.Id)
+	}
+
+	err = svc.ListTagsForResourcePages(&organizations.ListTagsForResourceInput{
+		ResourceId: aws.String(accountID),
+	}, func(page *organizations.ListTagsForResourceOutput, lastPage bool) bool {
+		for _, tag := range page.Tags {
+			account.Tags[aws.StringValue(tag.Key)] = aws.StringValue(tag.Value)
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to list tags of account %s", accountID)
+	}
+
+	return account, nil
+}
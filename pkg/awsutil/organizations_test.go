@@ -0,0 +1,62 @@
+package awsutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
+)
+
+func TestDescribeOrganizationAccount(t *testing.T) {
+	server := awstest.NewServer(t)
+	server.Handle("DescribeAccount", awstest.JSON(`{"Account": {"Id": "555133742", "Status": "ACTIVE"}}`))
+	server.Handle("ListParents",
+		awstest.JSON(`{"Parents": [{"Id": "ou-ab12-sandbox", "Type": "ORGANIZATIONAL_UNIT"}]}`),
+		awstest.JSON(`{"Parents": [{"Id": "ou-ab12-dev", "Type": "ORGANIZATIONAL_UNIT"}]}`),
+		awstest.JSON(`{"Parents": [{"Id": "r-ab12", "Type": "ROOT"}]}`))
+	server.Handle("ListTagsForResource",
+		awstest.JSON(`{"Tags": [{"Key": "nuke", "Value": "allowed"}], "NextToken": "page-2"}`),
+		awstest.JSON(`{"Tags": [{"Key": "team", "Value": "platform"}]}`))
+
+	account, err := server.Credentials("organizations").DescribeOrganizationAccount("555133742")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := &awsutil.OrganizationAccount{
+		ID:        "555133742",
+		Status:    "ACTIVE",
+		ParentIDs: []string{"ou-ab12-sandbox", "ou-ab12-dev", "r-ab12"},
+		Tags:      map[string]string{"nuke": "allowed", "team": "platform"},
+	}
+
+	if !reflect.DeepEqual(account, want) {
+		t.Errorf("Wrong account.\nWant: %#v\nHave: %#v", want, account)
+	}
+
+	if len(server.Requests("ListParents")) != 3 {
+		t.Errorf("Expected 3 ListParents requests, got %d", len(server.Requests("ListParents")))
+	}
+}
+
+func TestWithAssumedRole(t *testing.T) {
+	creds := &awsutil.Credentials{
+		Profile:       "sandbox",
+		AssumeRoleArn: "arn:aws:iam::555133742:role/nuke",
+	}
+
+	organization := creds.WithAssumedRole("arn:aws:iam::111111111111:role/organizations")
+
+	if organization.Profile != "sandbox" {
+		t.Errorf("Wrong profile: %s", organization.Profile)
+	}
+
+	if organization.AssumeRoleArn != "arn:aws:iam::111111111111:role/organizations" {
+		t.Errorf("Wrong role: %s", organization.AssumeRoleArn)
+	}
+
+	if creds.AssumeRoleArn != "arn:aws:iam::555133742:role/nuke" {
+		t.Errorf("The original credentials were changed: %s", creds.AssumeRoleArn)
+	}
+}
@@ -0,0 +1,53 @@
+package awsutil
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+)
+
+// DescribeRegions returns all regions of the partition together with their
+// opt-in status for the account. Custom stacks without EC2 cannot be asked,
+// so all their configured regions are returned as enabled.
+func (c *Credentials) DescribeRegions() ([]config.RegionStatus, error) {
+	if c.CustomEndpoints.GetRegion(DefaultRegionID) != nil && c.CustomEndpoints.GetURL(DefaultRegionID, "ec2") == "" {
+		regions := []config.RegionStatus{}
+		for _, custom := range c.CustomEndpoints {
+			regions = append(regions, config.RegionStatus{Name: custom.Region, Enabled: true})
+		}
+		return regions, nil
+	}
+
+	sess, err := c.NewSession(DefaultRegionID, "ec2")
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to create session in %s", DefaultRegionID)
+	}
+
+	resp, err := ec2.New(sess).DescribeRegions(&ec2.DescribeRegionsInput{
+		AllRegions: aws.Bool(true),
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to describe regions")
+	}
+
+	regions := []config.RegionStatus{}
+	for _, region := range resp.Regions {
+		name := aws.StringValue(region.RegionName)
+
+		// Regions which are unknown to the SDK are newer than it, but they
+		// are still in the partition of the default region.
+		partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), name)
+		if ok && partition.ID() != DefaultAWSPartitionID {
+			continue
+		}
+
+		regions = append(regions, config.RegionStatus{
+			Name:    name,
+			Enabled: aws.StringValue(region.OptInStatus) != "not-opted-in",
+		})
+	}
+
+	return regions, nil
+}
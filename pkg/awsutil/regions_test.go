@@ -0,0 +1,59 @@
+package awsutil_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+)
+
+func TestDescribeRegions(t *testing.T) {
+	server := awstest.NewServer(t)
+	server.Handle("DescribeRegions", awstest.XML(`<DescribeRegionsResponse><regionInfo>
+		<item><regionName>eu-west-1</regionName><optInStatus>opt-in-not-required</optInStatus></item>
+		<item><regionName>ap-east-1</regionName><optInStatus>not-opted-in</optInStatus></item>
+		<item><regionName>me-south-1</regionName><optInStatus>opted-in</optInStatus></item>
+		<item><regionName>xx-future-1</regionName><optInStatus>opted-in</optInStatus></item>
+		<item><regionName>cn-north-1</regionName><optInStatus>opt-in-not-required</optInStatus></item>
+	</regionInfo></DescribeRegionsResponse>`))
+
+	regions, err := server.Credentials("ec2").DescribeRegions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []config.RegionStatus{
+		{Name: "eu-west-1", Enabled: true},
+		{Name: "ap-east-1", Enabled: false},
+		{Name: "me-south-1", Enabled: true},
+		{Name: "xx-future-1", Enabled: true},
+	}
+
+	if !reflect.DeepEqual(regions, want) {
+		t.Errorf("Wrong regions.\nWant: %#v\nHave: %#v", want, regions)
+	}
+
+	requests := server.Requests("DescribeRegions")
+	if len(requests) != 1 || requests[0].Params.Get("AllRegions") != "true" {
+		t.Errorf("Expected one request for all regions, got %#v", requests)
+	}
+}
+
+func TestDescribeRegionsCustomEndpoints(t *testing.T) {
+	server := awstest.NewServer(t)
+
+	regions, err := server.Credentials("s3").DescribeRegions()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []config.RegionStatus{
+		{Name: awstest.Region, Enabled: true},
+		{Name: "us-east-1", Enabled: true},
+	}
+
+	if !reflect.DeepEqual(regions, want) {
+		t.Errorf("Wrong regions.\nWant: %#v\nHave: %#v", want, regions)
+	}
+}
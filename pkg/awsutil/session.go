@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"strings"
+	"sync"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/credentials"
@@ -41,7 +42,15 @@ type Credentials struct {
 	Credentials *credentials.Credentials
 
 	CustomEndpoints config.CustomEndpoints
-	session         *session.Session
+
+	// Cassette records or replays all AWS requests, if set.
+	Cassette *Cassette
+
+	// Throttler retries and rate limits all AWS requests, if set. Otherwise
+	// the default retryer of the SDK is used.
+	Throttler *Throttler
+
+	session *session.Session
 }
 
 func (c *Credentials) HasProfile() bool {
@@ -68,7 +77,15 @@ func (c *Credentials) Validate() error {
 	return nil
 }
 
+// rootSessionLock guards the creation of root sessions, since credentials
+// are shared between accounts, which are nuked in parallel. It is global,
+// because Credentials get copied by value.
+var rootSessionLock sync.Mutex
+
 func (c *Credentials) rootSession() (*session.Session, error) {
+	rootSessionLock.Lock()
+	defer rootSessionLock.Unlock()
+
 	if c.session == nil {
 		var opts session.Options
 
@@ -76,6 +93,15 @@ func (c *Credentials) rootSession() (*session.Session, error) {
 		log.Debugf("creating new root session in %s", region)
 
 		switch {
+		case c.Cassette.Replaying():
+			// Replayed requests are not checked, so there is no need to
+			// look up real credentials.
+			opts = session.Options{
+				Config: aws.Config{
+					Credentials: credentials.NewStaticCredentials("replay", "replay", ""),
+				},
+			}
+
 		case c.HasAwsCredentials():
 			opts = session.Options{
 				Config: aws.Config{
@@ -112,6 +138,10 @@ func (c *Credentials) rootSession() (*session.Session, error) {
 			return nil, err
 		}
 
+		if c.Cassette != nil {
+			c.Cassette.Install(sess)
+		}
+
 		// if given a role to assume, overwrite the session credentials with assume role credentials
 		if c.AssumeRoleArn != "" {
 			sess.Config.Credentials = stscreds.NewCredentials(sess, c.AssumeRoleArn)
@@ -123,6 +153,43 @@ func (c *Credentials) rootSession() (*session.Session, error) {
 	return c.session, nil
 }
 
+// WithAssumedRole returns a copy of the credentials, which assumes the given
+// role instead of the one from --assume-role-arn. The copy gets its own
+// session.
+func (c *Credentials) WithAssumedRole(roleArn string) *Credentials {
+	return &Credentials{
+		Profile:         c.Profile,
+		AccessKeyID:     c.AccessKeyID,
+		SecretAccessKey: c.SecretAccessKey,
+		SessionToken:    c.SessionToken,
+		AssumeRoleArn:   roleArn,
+		Credentials:     c.Credentials,
+		CustomEndpoints: c.CustomEndpoints,
+		Cassette:        c.Cassette,
+		Throttler:       c.Throttler,
+	}
+}
+
+// ForAccount returns credentials for another account, which are obtained by
+// assuming the role with the given name in that account. The role is assumed
+// with the root session, so it can be chained after --assume-role-arn.
+func (c *Credentials) ForAccount(accountID, roleName string) (*Credentials, error) {
+	root, err := c.rootSession()
+	if err != nil {
+		return nil, err
+	}
+
+	roleArn := fmt.Sprintf("arn:%s:iam::%s:role/%s", DefaultAWSPartitionID, accountID, roleName)
+	log.Debugf("assuming role %s", roleArn)
+
+	return &Credentials{
+		Credentials:     stscreds.NewCredentials(root, roleArn),
+		CustomEndpoints: c.CustomEndpoints,
+		Cassette:        c.Cassette,
+		Throttler:       c.Throttler.ForAccount(),
+	}, nil
+}
+
 func (c *Credentials) awsNewStaticCredentials() *credentials.Credentials {
 	if !c.HasKeys() {
 		return credentials.NewEnvCredentials()
@@ -170,6 +237,9 @@ func (c *Credentials) NewSession(region, serviceType string) (*session.Session,
 		if err != nil {
 			return nil, err
 		}
+		if c.Cassette != nil {
+			c.Cassette.Install(sess)
+		}
 		isCustom = true
 	}
 
@@ -192,6 +262,10 @@ func (c *Credentials) NewSession(region, serviceType string) (*session.Session,
 		log.Debugf("received AWS response:\n%s", DumpResponse(r.HTTPResponse))
 	})
 
+	if c.Throttler != nil {
+		c.Throttler.Install(sess)
+	}
+
 	if !isCustom {
 		sess.Handlers.Validate.PushFront(skipMissingServiceInRegionHandler)
 		sess.Handlers.Validate.PushFront(skipGlobalHandler(global))
@@ -0,0 +1,302 @@
+package awsutil
+
+import (
+	"context"
+	"math"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/client"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+	log "github.com/sirupsen/logrus"
+)
+
+// ServiceStats counts the requests to a single AWS service.
+type ServiceStats struct {
+	Service string
+
+	Requests  int
+	Throttled int
+	Retried   int
+}
+
+// A Throttler retries failed AWS requests with exponential backoff and limits
+// the request rate per service and region. The rate is unlimited at first
+// (unless configured otherwise), halved whenever AWS throttles a request and
+// slowly raised again with every successful request.
+type Throttler struct {
+	policy config.Retry
+
+	mu      sync.Mutex
+	buckets map[string]*tokenBucket
+	stats   map[string]*ServiceStats
+}
+
+func NewThrottler(policy config.Retry) *Throttler {
+	return &Throttler{
+		policy:  policy.WithDefaults(),
+		buckets: map[string]*tokenBucket{},
+		stats:   map[string]*ServiceStats{},
+	}
+}
+
+// ForAccount returns a new throttler with the same policy. AWS throttles per
+// account, so the rates and stats must not be shared between accounts.
+func (t *Throttler) ForAccount() *Throttler {
+	if t == nil {
+		return nil
+	}
+
+	return NewThrottler(t.policy)
+}
+
+// Install hooks the throttler into the session.
+func (t *Throttler) Install(sess *session.Session) {
+	sess.Config.Retryer = &retryer{
+		DefaultRetryer: client.DefaultRetryer{NumMaxRetries: *t.policy.MaxRetries},
+		policy:         t.policy,
+	}
+
+	// Signing happens before every attempt, including retries.
+	sess.Handlers.Sign.PushBack(t.waitHandler)
+	sess.Handlers.Retry.PushBack(t.retryHandler)
+	sess.Handlers.Complete.PushBack(t.completeHandler)
+}
+
+// Stats returns the counts of all services, which got requests, sorted by
+// service name.
+func (t *Throttler) Stats() []ServiceStats {
+	if t == nil {
+		return nil
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := []ServiceStats{}
+	for _, stats := range t.stats {
+		result = append(result, *stats)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Service < result[j].Service
+	})
+
+	return result
+}
+
+func (t *Throttler) bucket(r *request.Request) *tokenBucket {
+	key := r.ClientInfo.ServiceName + "/" + aws.StringValue(r.Config.Region)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	bucket, ok := t.buckets[key]
+	if !ok {
+		bucket = newTokenBucket(t.policy, time.Now)
+		t.buckets[key] = bucket
+	}
+
+	return bucket
+}
+
+func (t *Throttler) count(r *request.Request, fn func(*ServiceStats)) {
+	service := r.ClientInfo.ServiceName
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats, ok := t.stats[service]
+	if !ok {
+		stats = &ServiceStats{Service: service}
+		t.stats[service] = stats
+	}
+
+	fn(stats)
+}
+
+func (t *Throttler) waitHandler(r *request.Request) {
+	err := t.bucket(r).Wait(r.Context())
+	if err != nil {
+		r.Error = err
+	}
+}
+
+func (t *Throttler) retryHandler(r *request.Request) {
+	if !r.IsErrorThrottle() {
+		return
+	}
+
+	rate := t.bucket(r).Throttled()
+	log.Debugf("%s in %s throttled %s, limiting to %.1f requests per second",
+		r.ClientInfo.ServiceName, aws.StringValue(r.Config.Region), r.Operation.Name, rate)
+
+	t.count(r, func(s *ServiceStats) { s.Throttled++ })
+}
+
+func (t *Throttler) completeHandler(r *request.Request) {
+	if r.Error == nil {
+		t.bucket(r).Succeeded()
+	}
+
+	t.count(r, func(s *ServiceStats) {
+		s.Requests++
+		if r.RetryCount > 0 {
+			s.Retried++
+		}
+	})
+}
+
+// retryer decides like the SDK which requests are retried, but waits a
+// random time up to an exponentially growing limit ("full jitter"), so
+// parallel listers do not retry in lockstep.
+type retryer struct {
+	client.DefaultRetryer
+	policy config.Retry
+}
+
+func (r *retryer) RetryRules(req *request.Request) time.Duration {
+	return backoff(r.policy, req.RetryCount, rand.Float64())
+}
+
+func backoff(policy config.Retry, retryCount int, random float64) time.Duration {
+	limit := float64(policy.MinDelay) * math.Pow(2, float64(retryCount))
+	if limit > float64(policy.MaxDelay) {
+		limit = float64(policy.MaxDelay)
+	}
+
+	return time.Duration(random * limit)
+}
+
+// rateIncrease is added to the rate after every successful request. After
+// halving a rate of 20 requests per second, it takes about 200 requests to
+// get back to the old rate.
+const rateIncrease = 0.05
+
+// tokenBucket limits the request rate with additive increase and
+// multiplicative decrease. A rate of zero means no limit.
+type tokenBucket struct {
+	policy config.Retry
+	now    func() time.Time
+
+	mu     sync.Mutex
+	rate   float64
+	tokens float64
+	last   time.Time
+
+	// sent counts the requests of the current and the previous second, to
+	// estimate the rate when the first throttling happens.
+	window     time.Time
+	sent       int
+	sentBefore int
+
+	lastDecrease time.Time
+}
+
+func newTokenBucket(policy config.Retry, now func() time.Time) *tokenBucket {
+	return &tokenBucket{
+		policy: policy,
+		now:    now,
+		rate:   policy.RequestsPerSecond,
+		tokens: policy.RequestsPerSecond,
+		last:   now(),
+		window: now(),
+	}
+}
+
+// Wait blocks until the request may be sent.
+func (b *tokenBucket) Wait(ctx context.Context) error {
+	delay := b.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// reserve takes a token and returns how long to wait for it.
+func (b *tokenBucket) reserve() time.Duration {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+
+	if now.Sub(b.window) >= time.Second {
+		b.sentBefore = b.sent
+		if now.Sub(b.window) >= 2*time.Second {
+			b.sentBefore = 0
+		}
+		b.sent = 0
+		b.window = now
+	}
+	b.sent++
+
+	if b.rate == 0 {
+		return 0
+	}
+
+	// The bucket holds at most one second worth of tokens.
+	b.tokens = math.Min(b.rate, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// Throttled halves the rate and returns the new one. Requests which were
+// sent at the same time are likely throttled as well, so the rate is only
+// decreased once per second.
+func (b *tokenBucket) Throttled() float64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.now()
+	if now.Sub(b.lastDecrease) < time.Second {
+		return b.rate
+	}
+	b.lastDecrease = now
+
+	rate := b.rate
+	if rate == 0 {
+		rate = float64(b.sent + b.sentBefore)
+	}
+
+	b.rate = math.Max(b.policy.MinRequestsPerSecond, rate/2)
+	b.tokens = math.Min(b.tokens, 0)
+	b.last = now
+
+	return b.rate
+}
+
+// Succeeded raises the rate a bit, so it recovers after the throttling
+// stopped.
+func (b *tokenBucket) Succeeded() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.rate == 0 {
+		return
+	}
+
+	b.rate += rateIncrease
+	if b.policy.RequestsPerSecond > 0 && b.rate > b.policy.RequestsPerSecond {
+		b.rate = b.policy.RequestsPerSecond
+	}
+}
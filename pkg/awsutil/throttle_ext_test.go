@@ -0,0 +1,53 @@
+package awsutil_test
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awsutil"
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+)
+
+func TestThrottlerRetries(t *testing.T) {
+	server := awstest.NewServer(t)
+	server.Handle("GetCallerIdentity",
+		awstest.Error(http.StatusBadRequest, "Throttling", "Rate exceeded"),
+		awstest.Error(http.StatusBadRequest, "Throttling", "Rate exceeded"),
+		awstest.XML(`<GetCallerIdentityResponse><GetCallerIdentityResult>
+			<Account>555133742</Account>
+		</GetCallerIdentityResult></GetCallerIdentityResponse>`))
+
+	creds := server.Credentials("sts")
+	creds.Throttler = awsutil.NewThrottler(config.Retry{
+		MaxRetries: aws.Int(3),
+		MinDelay:   time.Millisecond,
+		MaxDelay:   time.Millisecond,
+	})
+
+	sess, err := creds.NewSession(awstest.Region, "sts")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := sts.New(sess).GetCallerIdentity(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if *out.Account != "555133742" {
+		t.Errorf("Wrong account %s", *out.Account)
+	}
+
+	want := []awsutil.ServiceStats{
+		{Service: "sts", Requests: 1, Throttled: 2, Retried: 1},
+	}
+
+	if have := creds.Throttler.Stats(); !reflect.DeepEqual(have, want) {
+		t.Errorf("Wrong stats.\nWant: %#v\nHave: %#v", want, have)
+	}
+}
@@ -0,0 +1,110 @@
+package awsutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
+)
+
+func TestBackoff(t *testing.T) {
+	policy := config.Retry{MinDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+
+	cases := []struct {
+		retryCount int
+		random     float64
+		want       time.Duration
+	}{
+		{0, 1, 100 * time.Millisecond},
+		{1, 1, 200 * time.Millisecond},
+		{3, 1, 800 * time.Millisecond},
+		{4, 1, time.Second},
+		{20, 1, time.Second},
+		{3, 0.5, 400 * time.Millisecond},
+		{3, 0, 0},
+	}
+
+	for _, tc := range cases {
+		have := backoff(policy, tc.retryCount, tc.random)
+		if have != tc.want {
+			t.Errorf("backoff(%d, %v) = %v, want %v", tc.retryCount, tc.random, have, tc.want)
+		}
+	}
+}
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	return c.now
+}
+
+func TestTokenBucketAdapts(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}
+	policy := config.Retry{MinRequestsPerSecond: 2}
+	bucket := newTokenBucket(policy, clock.Now)
+
+	// Without a limit nothing waits.
+	for i := 0; i < 40; i++ {
+		if delay := bucket.reserve(); delay != 0 {
+			t.Fatalf("Expected no delay without limit, got %v", delay)
+		}
+	}
+
+	// The first throttling estimates the rate from the sent requests.
+	if rate := bucket.Throttled(); rate != 20 {
+		t.Fatalf("Expected rate 20 after throttling, got %v", rate)
+	}
+
+	// Throttling of parallel requests only decreases the rate once.
+	if rate := bucket.Throttled(); rate != 20 {
+		t.Fatalf("Expected rate to stay at 20, got %v", rate)
+	}
+
+	if delay := bucket.reserve(); delay != 50*time.Millisecond {
+		t.Fatalf("Expected a delay of 50ms, got %v", delay)
+	}
+
+	clock.now = clock.now.Add(time.Second)
+	if delay := bucket.reserve(); delay != 0 {
+		t.Fatalf("Expected no delay after refill, got %v", delay)
+	}
+
+	for i := 0; i < 5; i++ {
+		clock.now = clock.now.Add(time.Second)
+		bucket.Throttled()
+	}
+	if bucket.rate != 2 {
+		t.Fatalf("Expected the minimum rate of 2, got %v", bucket.rate)
+	}
+
+	for i := 0; i < 20; i++ {
+		bucket.Succeeded()
+	}
+	if bucket.rate < 2.99 || bucket.rate > 3.01 {
+		t.Fatalf("Expected the rate to recover to 3, got %v", bucket.rate)
+	}
+}
+
+func TestTokenBucketConfiguredRate(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2026, 10, 18, 12, 0, 0, 0, time.UTC)}
+	bucket := newTokenBucket(config.Retry{RequestsPerSecond: 4, MinRequestsPerSecond: 1}, clock.Now)
+
+	for i := 0; i < 4; i++ {
+		if delay := bucket.reserve(); delay != 0 {
+			t.Fatalf("Expected no delay for request %d, got %v", i, delay)
+		}
+	}
+
+	if delay := bucket.reserve(); delay != 250*time.Millisecond {
+		t.Fatalf("Expected a delay of 250ms, got %v", delay)
+	}
+
+	for i := 0; i < 100; i++ {
+		bucket.Succeeded()
+	}
+	if bucket.rate != 4 {
+		t.Fatalf("Expected the rate to stay at the configured 4, got %v", bucket.rate)
+	}
+}
@@ -1,10 +1,14 @@
 package config
 
 import (
-	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
-	"io/ioutil"
+	"regexp"
+	"sort"
 	"strings"
+	"time"
+	"unicode"
 
 	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
 
@@ -19,21 +23,222 @@ type ResourceTypes struct {
 }
 
 type Account struct {
+	Filters       Filters                 `yaml:"filters"`
+	ResourceTypes ResourceTypes           `yaml:"resource-types"`
+	Presets       []string                `yaml:"presets"`
+	Regions       map[string]RegionConfig `yaml:"regions"`
+}
+
+// RegionConfig contains filters and resource types, which only apply to a
+// single region. They are added to the ones of the account. Global
+// resources use the region 'global'.
+type RegionConfig struct {
 	Filters       Filters       `yaml:"filters"`
 	ResourceTypes ResourceTypes `yaml:"resource-types"`
-	Presets       []string      `yaml:"presets"`
 }
 
 type Nuke struct {
+	// Include lists other config files, which are merged into this one.
+	// It is resolved by Load and always empty afterwards.
+	Include []string `yaml:"include"`
+
 	// Deprecated: Use AccountBlocklist instead.
-	AccountBlacklist []string                     `yaml:"account-blacklist"`
-	AccountBlocklist []string                     `yaml:"account-blocklist"`
-	Regions          []string                     `yaml:"regions"`
-	Accounts         map[string]Account           `yaml:"accounts"`
-	ResourceTypes    ResourceTypes                `yaml:"resource-types"`
-	Presets          map[string]PresetDefinitions `yaml:"presets"`
-	FeatureFlags     FeatureFlags                 `yaml:"feature-flags"`
-	CustomEndpoints  CustomEndpoints              `yaml:"endpoints"`
+	AccountBlacklist []string `yaml:"account-blacklist"`
+	AccountBlocklist []string `yaml:"account-blocklist"`
+
+	// AccountAllowlist, AllowedOUs and AllowedAccountTags limit aws-nuke to
+	// the matching accounts. If more than one of them is set, an account has
+	// to match all of them. The organizational units and tags are looked up
+	// in AWS Organizations at runtime.
+	AccountAllowlist   []string          `yaml:"account-allowlist"`
+	AllowedOUs         []string          `yaml:"allowed-ous"`
+	AllowedAccountTags map[string]string `yaml:"allowed-account-tags"`
+
+	AliasBlocklist AliasBlocklist `yaml:"alias-blocklist"`
+
+	// Regions may contain the keywords 'all' and 'enabled', which are
+	// expanded at runtime, and exclude patterns like '!ap-*'.
+	Regions         []string                     `yaml:"regions"`
+	Accounts        map[string]Account           `yaml:"accounts"`
+	ResourceTypes   ResourceTypes                `yaml:"resource-types"`
+	OlderThan       time.Duration                `yaml:"older-than"`
+	Presets         map[string]PresetDefinitions `yaml:"presets"`
+	FeatureFlags    FeatureFlags                 `yaml:"feature-flags"`
+	CustomEndpoints CustomEndpoints              `yaml:"endpoints"`
+
+	// ServiceRemovalLimits caps the number of concurrent removals per
+	// service. The service is matched case-insensitively as leading words of
+	// the resource type, eg "iam" for IAMRole, but "ec" for neither EC2 nor
+	// ECS.
+	ServiceRemovalLimits map[string]int `yaml:"service-removal-limits"`
+
+	Safety Safety `yaml:"safety"`
+	Audit  Audit  `yaml:"audit"`
+	Retry  Retry  `yaml:"retry"`
+
+	hash string
+}
+
+// Hash identifies the loaded config. It covers all included files and the
+// values of the interpolated environment variables, so it changes whenever
+// the effective config changes.
+func (c *Nuke) Hash() string {
+	return c.hash
+}
+
+// Retry configures how failed AWS requests are retried and how the request
+// rate adapts to throttling.
+type Retry struct {
+	// MaxRetries is a pointer, so an explicit 0, which disables retries,
+	// can be told apart from an unset value.
+	MaxRetries *int `yaml:"max-retries"`
+
+	// MinDelay and MaxDelay limit the exponential backoff between retries.
+	MinDelay time.Duration `yaml:"min-delay"`
+	MaxDelay time.Duration `yaml:"max-delay"`
+
+	// RequestsPerSecond is the initial limit per service and region. Zero
+	// means no limit until the first request gets throttled.
+	RequestsPerSecond float64 `yaml:"requests-per-second"`
+
+	// MinRequestsPerSecond is the lowest limit after throttling.
+	MinRequestsPerSecond float64 `yaml:"min-requests-per-second"`
+}
+
+const (
+	DefaultMaxRetries           = 10
+	DefaultMinRetryDelay        = 100 * time.Millisecond
+	DefaultMaxRetryDelay        = 20 * time.Second
+	DefaultMinRequestsPerSecond = 1
+)
+
+// WithDefaults returns a copy with the defaults for all unset values.
+func (r Retry) WithDefaults() Retry {
+	if r.MaxRetries == nil {
+		maxRetries := DefaultMaxRetries
+		r.MaxRetries = &maxRetries
+	}
+	if r.MinDelay == 0 {
+		r.MinDelay = DefaultMinRetryDelay
+	}
+	if r.MaxDelay == 0 {
+		r.MaxDelay = DefaultMaxRetryDelay
+	}
+	if r.MinRequestsPerSecond == 0 {
+		r.MinRequestsPerSecond = DefaultMinRequestsPerSecond
+	}
+
+	return r
+}
+
+func (r Retry) validate() error {
+	if r.MaxRetries != nil && *r.MaxRetries < 0 {
+		return fmt.Errorf("retry.max-retries must not be negative")
+	}
+
+	if r.MinDelay < 0 || r.MaxDelay < 0 {
+		return fmt.Errorf("retry delays must not be negative")
+	}
+
+	if r.MaxDelay != 0 && r.MinDelay > r.MaxDelay {
+		return fmt.Errorf("retry.min-delay must not be greater than retry.max-delay")
+	}
+
+	if r.RequestsPerSecond < 0 || r.MinRequestsPerSecond < 0 {
+		return fmt.Errorf("request rates must not be negative")
+	}
+
+	return nil
+}
+
+// AliasBlocklist protects accounts by their alias. The patterns are regular
+// expressions, which are matched anywhere in the alias. If no patterns are
+// configured, aliases containing "prod" are blocked.
+type AliasBlocklist struct {
+	Patterns      []string `yaml:"patterns"`
+	CaseSensitive bool     `yaml:"case-sensitive"`
+}
+
+var DefaultAliasBlocklistPatterns = []string{"prod"}
+
+func (a AliasBlocklist) patterns() []string {
+	if a.Patterns == nil {
+		return DefaultAliasBlocklistPatterns
+	}
+
+	return a.Patterns
+}
+
+func (a AliasBlocklist) compile(pattern string) (*regexp.Regexp, error) {
+	if !a.CaseSensitive {
+		pattern = "(?i)" + pattern
+	}
+
+	return regexp.Compile(pattern)
+}
+
+// Match returns the first pattern which matches the alias.
+func (a AliasBlocklist) Match(alias string) (string, bool) {
+	for _, pattern := range a.patterns() {
+		re, err := a.compile(pattern)
+		if err != nil {
+			// The patterns are validated when loading the config.
+			continue
+		}
+
+		if re.MatchString(alias) {
+			return pattern, true
+		}
+	}
+
+	return "", false
+}
+
+// Audit configures where the manifest of each run is stored. It is written
+// to the directory and uploaded to the bucket, if they are set.
+type Audit struct {
+	Directory string `yaml:"directory"`
+
+	Bucket    string `yaml:"bucket"`
+	KeyPrefix string `yaml:"key-prefix"`
+	Region    string `yaml:"region"`
+}
+
+func (a Audit) Enabled() bool {
+	return a.Directory != "" || a.Bucket != ""
+}
+
+type Safety struct {
+	FinalSnapshot FinalSnapshot `yaml:"final-snapshot"`
+}
+
+// FinalSnapshot configures snapshots of stateful resources, which are taken
+// right before they are removed.
+type FinalSnapshot struct {
+	Enabled bool `yaml:"enabled"`
+
+	// ResourceTypes limits the snapshots to these resource types. By
+	// default all resource types which support snapshots are saved.
+	ResourceTypes types.Collection `yaml:"resource-types"`
+
+	// Retention protects the snapshots from being removed by later runs
+	// for this duration.
+	Retention time.Duration `yaml:"retention"`
+
+	// BackupVault and BackupRoleARN are used for resources without native
+	// snapshots, which are backed up with AWS Backup instead.
+	BackupVault   string `yaml:"backup-vault"`
+	BackupRoleARN string `yaml:"backup-role-arn"`
+}
+
+// Applies checks whether a final snapshot should be taken of resources with
+// the given type.
+func (fs FinalSnapshot) Applies(resourceType string) bool {
+	if !fs.Enabled {
+		return false
+	}
+
+	return len(fs.ResourceTypes) == 0 || fs.ResourceTypes.Contains(resourceType)
 }
 
 type FeatureFlags struct {
@@ -55,7 +260,8 @@ type DisableDeletionProtection struct {
 }
 
 type PresetDefinitions struct {
-	Filters Filters `yaml:"filters"`
+	Filters Filters                 `yaml:"filters"`
+	Regions map[string]RegionConfig `yaml:"regions"`
 }
 
 type CustomService struct {
@@ -75,25 +281,60 @@ type CustomRegion struct {
 type CustomEndpoints []*CustomRegion
 
 func Load(path string) (*Nuke, error) {
-	var err error
-
-	raw, err := ioutil.ReadFile(path)
+	node, err := loadNode(path, nil)
 	if err != nil {
 		return nil, err
 	}
 
 	config := new(Nuke)
-	dec := yaml.NewDecoder(bytes.NewReader(raw))
-	dec.KnownFields(true)
-	err = dec.Decode(&config)
+	err = node.Decode(config)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := yaml.Marshal(node)
 	if err != nil {
 		return nil, err
 	}
 
+	sum := sha256.Sum256(raw)
+	config.hash = hex.EncodeToString(sum[:])
+
 	if err := config.resolveDeprecations(); err != nil {
 		return nil, err
 	}
 
+	for service, limit := range config.ServiceRemovalLimits {
+		if limit < 1 {
+			return nil, fmt.Errorf("removal limit for service '%s' must be at least 1, but is %d", service, limit)
+		}
+	}
+
+	for _, pattern := range config.AliasBlocklist.Patterns {
+		_, err := config.AliasBlocklist.compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid alias blocklist pattern '%s': %w", pattern, err)
+		}
+	}
+
+	err = config.Retry.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	err = validateRegions(config.Regions)
+	if err != nil {
+		return nil, err
+	}
+
+	if config.OlderThan < 0 {
+		return nil, fmt.Errorf("older-than must not be negative")
+	}
+
+	if config.Safety.FinalSnapshot.Retention < 0 {
+		return nil, fmt.Errorf("retention of final snapshots must not be negative")
+	}
+
 	return config, nil
 }
 
@@ -121,11 +362,33 @@ func (c *Nuke) InBlocklist(searchID string) bool {
 	return false
 }
 
+// HasAllowlist returns true, if aws-nuke is limited to certain accounts.
+func (c *Nuke) HasAllowlist() bool {
+	return len(c.AccountAllowlist) > 0 || c.RequiresOrganization()
+}
+
+// RequiresOrganization returns true, if the account has to be looked up in
+// AWS Organizations to check whether it may be nuked.
+func (c *Nuke) RequiresOrganization() bool {
+	return len(c.AllowedOUs) > 0 || len(c.AllowedAccountTags) > 0
+}
+
+func (c *Nuke) InAllowlist(searchID string) bool {
+	for _, allowlistID := range c.AccountAllowlist {
+		if allowlistID == searchID {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c *Nuke) ValidateAccount(accountID string, aliases []string) error {
-	if !c.HasBlocklist() {
+	if !c.HasBlocklist() && !c.HasAllowlist() {
 		return fmt.Errorf("The config file contains an empty blocklist. " +
 			"For safety reasons you need to specify at least one account ID. " +
-			"This should be your production account.")
+			"This should be your production account. Alternatively you can " +
+			"specify the only accounts which may be nuked with an allowlist.")
 	}
 
 	if c.InBlocklist(accountID) {
@@ -133,6 +396,11 @@ func (c *Nuke) ValidateAccount(accountID string, aliases []string) error {
 			"but it is blocklisted. Aborting.", accountID)
 	}
 
+	if len(c.AccountAllowlist) > 0 && !c.InAllowlist(accountID) {
+		return fmt.Errorf("You are trying to nuke the account with the ID %s, "+
+			"but it is not in the account-allowlist. Aborting.", accountID)
+	}
+
 	if len(aliases) == 0 {
 		return fmt.Errorf("The specified account doesn't have an alias. " +
 			"For safety reasons you need to specify an account alias. " +
@@ -140,9 +408,10 @@ func (c *Nuke) ValidateAccount(accountID string, aliases []string) error {
 	}
 
 	for _, alias := range aliases {
-		if strings.Contains(strings.ToLower(alias), "prod") {
+		pattern, blocked := c.AliasBlocklist.Match(alias)
+		if blocked {
 			return fmt.Errorf("You are trying to nuke an account with the alias '%s', "+
-				"but it has the substring 'prod' in it. Aborting.", alias)
+				"but it matches the blocked pattern '%s'. Aborting.", alias, pattern)
 		}
 	}
 
@@ -154,13 +423,53 @@ func (c *Nuke) ValidateAccount(accountID string, aliases []string) error {
 	return nil
 }
 
+// ValidateOrganizationAccount checks the organizational units and tags of the
+// account from AWS Organizations against allowed-ous and
+// allowed-account-tags.
+func (c *Nuke) ValidateOrganizationAccount(accountID string, parentIDs []string, tags map[string]string) error {
+	if len(c.AllowedOUs) > 0 {
+		allowed := false
+		for _, parentID := range parentIDs {
+			for _, ou := range c.AllowedOUs {
+				if parentID == ou {
+					allowed = true
+				}
+			}
+		}
+
+		if !allowed {
+			return fmt.Errorf("You are trying to nuke the account with the ID %s, "+
+				"but it is not in any of the allowed-ous %v. Aborting.", accountID, c.AllowedOUs)
+		}
+	}
+
+	for _, key := range sortedStringKeys(c.AllowedAccountTags) {
+		value, ok := tags[key]
+		if !ok || value != c.AllowedAccountTags[key] {
+			return fmt.Errorf("You are trying to nuke the account with the ID %s, "+
+				"but it does not have the tag %s=%s in AWS Organizations. Aborting.",
+				accountID, key, c.AllowedAccountTags[key])
+		}
+	}
+
+	return nil
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
 func (c *Nuke) Filters(accountID string) (Filters, error) {
 	account := c.Accounts[accountID]
-	filters := account.Filters
 
-	if filters == nil {
-		filters = Filters{}
-	}
+	// Copy the filters, so merging the presets does not modify the config.
+	filters := Filters{}
+	filters.Merge(account.Filters)
 
 	if account.Presets == nil {
 		return filters, nil
@@ -183,6 +492,79 @@ func (c *Nuke) Filters(accountID string) (Filters, error) {
 	return filters, nil
 }
 
+// RegionFilters returns the filters of the account and its presets, which
+// apply to resources in the region. These are the account wide ones plus the
+// ones of the region.
+func (c *Nuke) RegionFilters(accountID, region string) (Filters, error) {
+	withOrigin, err := c.FiltersWithOrigin(accountID, region)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := Filters{}
+	for resourceType, list := range withOrigin {
+		for _, filter := range list {
+			filters[resourceType] = append(filters[resourceType], filter.Filter)
+		}
+	}
+
+	return filters, nil
+}
+
+// RegionResourceTypes returns the resource types of the account and its
+// presets, which only apply to the region.
+func (c *Nuke) RegionResourceTypes(accountID, region string) []ResourceTypes {
+	account := c.Accounts[accountID]
+
+	result := []ResourceTypes{account.Regions[region].ResourceTypes}
+	for _, presetName := range account.Presets {
+		result = append(result, c.Presets[presetName].Regions[region].ResourceTypes)
+	}
+
+	return result
+}
+
+// ServiceRemovalLimit returns the service with the longest prefix matching
+// the resource type and its limit of concurrent removals. The service is empty
+// if there is no limit for the resource type.
+func (c *Nuke) ServiceRemovalLimit(resourceType string) (string, int) {
+	var (
+		service string
+		limit   int
+	)
+
+	name := strings.ToLower(resourceType)
+	for s, l := range c.ServiceRemovalLimits {
+		prefix := strings.ToLower(s)
+		if strings.HasPrefix(name, prefix) && isWordStart(resourceType, len(prefix)) && len(prefix) > len(service) {
+			service = prefix
+			limit = l
+		}
+	}
+
+	return service, limit
+}
+
+// isWordStart checks whether a new word of the CamelCase name starts at the
+// index, so a prefix ending there consists of whole words. In acronyms the
+// last capital letter starts the next word, eg "ECSCluster" is "ECS" and
+// "Cluster".
+func isWordStart(name string, i int) bool {
+	if i == 0 || i >= len(name) {
+		return true
+	}
+
+	if !unicode.IsUpper(rune(name[i])) {
+		return false
+	}
+
+	if !unicode.IsUpper(rune(name[i-1])) {
+		return true
+	}
+
+	return i+1 < len(name) && unicode.IsLower(rune(name[i+1]))
+}
+
 func (c *Nuke) resolveDeprecations() error {
 	deprecations := map[string]string{
 		"EC2DhcpOptions":                "EC2DHCPOptions",
@@ -7,6 +7,7 @@ import (
 	"testing"
 
 	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+	"gopkg.in/yaml.v3"
 )
 
 func TestConfigBlocklist(t *testing.T) {
@@ -105,6 +106,9 @@ func TestLoadExampleConfig(t *testing.T) {
 		},
 	}
 
+	// The hash is checked by TestLoadHash.
+	expect.hash = config.Hash()
+
 	if !reflect.DeepEqual(*config, expect) {
 		t.Errorf("Read struct mismatches:")
 		t.Errorf("  Got:      %#v", *config)
@@ -306,3 +310,171 @@ func TestGetCustomRegion(t *testing.T) {
 
 	})
 }
+
+func TestRetryWithDefaults(t *testing.T) {
+	cases := []struct {
+		name string
+		yaml string
+		want int
+	}{
+		{"Unset", "min-delay: 1s", DefaultMaxRetries},
+		{"Disabled", "max-retries: 0", 0},
+		{"Explicit", "max-retries: 3", 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var retry Retry
+			err := yaml.Unmarshal([]byte(tc.yaml), &retry)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			have := *retry.WithDefaults().MaxRetries
+			if have != tc.want {
+				t.Fatalf("Wrong max retries. Want: %d. Have: %d", tc.want, have)
+			}
+		})
+	}
+}
+
+func TestServiceRemovalLimit(t *testing.T) {
+	config := Nuke{
+		ServiceRemovalLimits: map[string]int{
+			"ec2":    4,
+			"ec2vpc": 2,
+			"IAM":    1,
+			"ec":     8,
+			"ecs":    3,
+		},
+	}
+
+	cases := []struct {
+		resourceType string
+		service      string
+		limit        int
+	}{
+		{"EC2Instance", "ec2", 4},
+		{"EC2VPC", "ec2vpc", 2},
+		{"EC2VPCEndpoint", "ec2vpc", 2},
+		{"EC2VPNGateway", "ec2", 4},
+		{"IAMRole", "iam", 1},
+		{"ECSCluster", "ecs", 3},
+		{"ECRRepository", "", 0},
+		{"S3Bucket", "", 0},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.resourceType, func(t *testing.T) {
+			service, limit := config.ServiceRemovalLimit(tc.resourceType)
+			if service != tc.service || limit != tc.limit {
+				t.Fatalf("Wrong limit. Want: %s=%d. Have: %s=%d", tc.service, tc.limit, service, limit)
+			}
+		})
+	}
+}
+
+func TestAccountAllowlist(t *testing.T) {
+	config := &Nuke{
+		AccountAllowlist: []string{"555133742"},
+		Accounts: map[string]Account{
+			"555133742": {},
+			"555133743": {},
+		},
+	}
+
+	err := config.ValidateAccount("555133742", []string{"sandbox"})
+	if err != nil {
+		t.Errorf("Didn't expect an error, but got one: %v", err)
+	}
+
+	err = config.ValidateAccount("555133743", []string{"sandbox"})
+	if err == nil || !strings.Contains(err.Error(), "not in the account-allowlist") {
+		t.Errorf("Expected an allowlist error, got %v", err)
+	}
+}
+
+func TestAliasBlocklist(t *testing.T) {
+	cases := []struct {
+		blocklist AliasBlocklist
+		alias     string
+		blocked   bool
+	}{
+		{AliasBlocklist{}, "my-Production", true},
+		{AliasBlocklist{}, "sandbox", false},
+		{AliasBlocklist{Patterns: []string{"^live-", "prd$"}}, "live-shop", true},
+		{AliasBlocklist{Patterns: []string{"^live-", "prd$"}}, "shop-PRD", true},
+		{AliasBlocklist{Patterns: []string{"^live-", "prd$"}}, "production", false},
+		{AliasBlocklist{Patterns: []string{"PROD"}, CaseSensitive: true}, "prod", false},
+		{AliasBlocklist{Patterns: []string{"PROD"}, CaseSensitive: true}, "PROD", true},
+		{AliasBlocklist{Patterns: []string{}}, "prod", false},
+	}
+
+	for i, tc := range cases {
+		t.Run(fmt.Sprintf("%d_%s", i, tc.alias), func(t *testing.T) {
+			config := &Nuke{
+				AccountBlocklist: []string{"1234567890"},
+				AliasBlocklist:   tc.blocklist,
+				Accounts:         map[string]Account{"555133742": {}},
+			}
+
+			err := config.ValidateAccount("555133742", []string{tc.alias})
+			if tc.blocked && err == nil {
+				t.Fatal("Expected an error but didn't get one.")
+			}
+			if !tc.blocked && err != nil {
+				t.Fatalf("Didn't expect an error, but got one: %v", err)
+			}
+		})
+	}
+}
+
+func TestValidateOrganizationAccount(t *testing.T) {
+	config := &Nuke{
+		AllowedOUs:         []string{"ou-ab12-sandbox"},
+		AllowedAccountTags: map[string]string{"nuke": "allowed"},
+	}
+
+	cases := []struct {
+		name    string
+		parents []string
+		tags    map[string]string
+		err     string
+	}{
+		{
+			name:    "allowed",
+			parents: []string{"ou-ab12-team", "ou-ab12-sandbox", "r-ab12"},
+			tags:    map[string]string{"nuke": "allowed", "team": "platform"},
+		},
+		{
+			name:    "other-ou",
+			parents: []string{"ou-ab12-prod", "r-ab12"},
+			tags:    map[string]string{"nuke": "allowed"},
+			err:     "not in any of the allowed-ous",
+		},
+		{
+			name:    "missing-tag",
+			parents: []string{"ou-ab12-sandbox", "r-ab12"},
+			tags:    map[string]string{},
+			err:     "does not have the tag nuke=allowed",
+		},
+		{
+			name:    "wrong-tag",
+			parents: []string{"ou-ab12-sandbox", "r-ab12"},
+			tags:    map[string]string{"nuke": "never"},
+			err:     "does not have the tag nuke=allowed",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := config.ValidateOrganizationAccount("555133742", tc.parents, tc.tags)
+			if tc.err == "" && err != nil {
+				t.Fatalf("Didn't expect an error, but got one: %v", err)
+			}
+			if tc.err != "" && (err == nil || !strings.Contains(err.Error(), tc.err)) {
+				t.Fatalf("Expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
@@ -9,19 +9,48 @@ import (
 
 	"github.com/mb0/glob"
 	log "github.com/sirupsen/logrus"
+	"gopkg.in/yaml.v3"
 )
 
 type FilterType string
 
 const (
-	FilterTypeEmpty         FilterType = ""
-	FilterTypeExact                    = "exact"
-	FilterTypeGlob                     = "glob"
-	FilterTypeRegex                    = "regex"
-	FilterTypeContains                 = "contains"
-	FilterTypeDateOlderThan            = "dateOlderThan"
+	FilterTypeEmpty             FilterType = ""
+	FilterTypeExact                        = "exact"
+	FilterTypeGlob                         = "glob"
+	FilterTypeRegex                        = "regex"
+	FilterTypeContains                     = "contains"
+	FilterTypeDateOlderThan                = "dateOlderThan"
+	FilterTypeDateNewerThan                = "dateNewerThan"
+	FilterTypeDateBefore                   = "dateBefore"
+	FilterTypeDateAfter                    = "dateAfter"
+	FilterTypeNumberGreaterThan            = "numberGreaterThan"
+	FilterTypeNumberLessThan               = "numberLessThan"
+	FilterTypeExists                       = "exists"
+	FilterTypeMissing                      = "missing"
 )
 
+var filterTypes = []FilterType{
+	FilterTypeEmpty, FilterTypeExact, FilterTypeGlob, FilterTypeRegex,
+	FilterTypeContains, FilterTypeDateOlderThan, FilterTypeDateNewerThan,
+	FilterTypeDateBefore, FilterTypeDateAfter, FilterTypeNumberGreaterThan,
+	FilterTypeNumberLessThan, FilterTypeExists, FilterTypeMissing,
+}
+
+func (t FilterType) IsKnown() bool {
+	for _, known := range filterTypes {
+		if t == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+// GlobalFiltersKey is used in place of a resource type to define filters
+// which apply to all resource types.
+const GlobalFiltersKey = "__global__"
+
 type Filters map[string][]Filter
 
 func (f Filters) Merge(f2 Filters) {
@@ -35,6 +64,40 @@ type Filter struct {
 	Type     FilterType
 	Value    string
 	Invert   string
+
+	// All, Any and Not make up a composite filter, which matches when all,
+	// any or none of the nested filters match. A composite filter cannot
+	// have a property or type itself.
+	All []Filter
+	Any []Filter
+	Not *Filter
+}
+
+// IsComposite returns true, if the filter combines other filters instead of
+// matching a property.
+func (f Filter) IsComposite() bool {
+	return f.All != nil || f.Any != nil || f.Not != nil
+}
+
+// Properties returns the names of all properties the filter looks at,
+// including the ones of nested filters.
+func (f Filter) Properties() []string {
+	if !f.IsComposite() {
+		return []string{f.Property}
+	}
+
+	properties := []string{}
+	for _, nested := range f.All {
+		properties = append(properties, nested.Properties()...)
+	}
+	for _, nested := range f.Any {
+		properties = append(properties, nested.Properties()...)
+	}
+	if f.Not != nil {
+		properties = append(properties, f.Not.Properties()...)
+	}
+
+	return properties
 }
 
 func (f Filter) Match(o string, c *Nuke) (bool, error) {
@@ -58,7 +121,11 @@ func (f Filter) Match(o string, c *Nuke) (bool, error) {
 		}
 		return re.MatchString(o), nil
 
-	case FilterTypeDateOlderThan:
+	case FilterTypeExists, FilterTypeMissing:
+		return false, fmt.Errorf("filter type %s needs to know whether the property is set "+
+			"and cannot be matched against a value", f.Type)
+
+	case FilterTypeDateOlderThan, FilterTypeDateNewerThan:
 		if o == "" {
 			return false, nil
 		}
@@ -82,8 +149,52 @@ func (f Filter) Match(o string, c *Nuke) (bool, error) {
 		}
 		fieldTimeWithOffset := fieldTime.Add(duration)
 
+		if f.Type == FilterTypeDateNewerThan {
+			return !fieldTimeWithOffset.After(time.Now()), nil
+		}
 		return fieldTimeWithOffset.After(time.Now()), nil
 
+	case FilterTypeDateBefore, FilterTypeDateAfter:
+		if o == "" {
+			return false, nil
+		}
+		limit, err := parseDate(f.Value)
+		if err != nil {
+			return false, err
+		}
+		fieldTime, err := parseDate(o)
+		if err != nil {
+			if c.FeatureFlags.NukeOnDateParseError {
+				log.Warnf("Failed to parse date %s: %s", o, err)
+				return false, nil
+			} else {
+				return false, err
+			}
+		}
+
+		if f.Type == FilterTypeDateBefore {
+			return fieldTime.Before(limit), nil
+		}
+		return fieldTime.After(limit), nil
+
+	case FilterTypeNumberGreaterThan, FilterTypeNumberLessThan:
+		if o == "" {
+			return false, nil
+		}
+		limit, err := strconv.ParseFloat(f.Value, 64)
+		if err != nil {
+			return false, err
+		}
+		number, err := strconv.ParseFloat(o, 64)
+		if err != nil {
+			return false, fmt.Errorf("unable to parse number %s", o)
+		}
+
+		if f.Type == FilterTypeNumberGreaterThan {
+			return number > limit, nil
+		}
+		return number < limit, nil
+
 	default:
 		return false, fmt.Errorf("unknown type %s", f.Type)
 	}
@@ -112,25 +223,91 @@ func parseDate(input string) (time.Time, error) {
 	return time.Now(), fmt.Errorf("unable to parse time %s", input)
 }
 
-func (f *Filter) UnmarshalYAML(unmarshal func(interface{}) error) error {
+func (f *Filter) UnmarshalYAML(node *yaml.Node) error {
 	var value string
 
-	if unmarshal(&value) == nil {
+	if node.Decode(&value) == nil {
 		f.Type = FilterTypeExact
 		f.Value = value
 		return nil
 	}
 
-	m := map[string]string{}
-	err := unmarshal(m)
+	var m struct {
+		Property string   `yaml:"property"`
+		Type     string   `yaml:"type"`
+		Value    string   `yaml:"value"`
+		Invert   string   `yaml:"invert"`
+		All      []Filter `yaml:"all"`
+		Any      []Filter `yaml:"any"`
+		Not      *Filter  `yaml:"not"`
+	}
+	err := node.Decode(&m)
 	if err != nil {
 		return err
 	}
 
-	f.Type = FilterType(m["type"])
-	f.Value = m["value"]
-	f.Property = m["property"]
-	f.Invert = m["invert"]
+	// An empty 'all' would match every resource and an empty 'any' none,
+	// which is most likely a mistake.
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i].Value
+		if (key == "all" || key == "any") && len(node.Content[i+1].Content) == 0 {
+			return fmt.Errorf("'%s' must contain at least one filter", key)
+		}
+	}
+
+	f.Type = FilterType(m.Type)
+	f.Value = m.Value
+	f.Property = m.Property
+	f.Invert = m.Invert
+	f.All = m.All
+	f.Any = m.Any
+	f.Not = m.Not
+
+	if !f.IsComposite() {
+		return f.validateValue()
+	}
+
+	composites := 0
+	for _, set := range []bool{f.All != nil, f.Any != nil, f.Not != nil} {
+		if set {
+			composites++
+		}
+	}
+	if composites > 1 {
+		return fmt.Errorf("a filter can only have one of 'all', 'any' or 'not'")
+	}
+
+	if f.Property != "" || f.Type != FilterTypeEmpty || f.Value != "" {
+		return fmt.Errorf("a filter with 'all', 'any' or 'not' cannot have a property, type or value")
+	}
+
+	return nil
+}
+
+// validateValue checks the value of filter types, which expect a certain
+// format, so mistakes show up when loading the config instead of in the
+// middle of a run.
+func (f Filter) validateValue() error {
+	switch f.Type {
+	case FilterTypeDateOlderThan, FilterTypeDateNewerThan:
+		_, err := time.ParseDuration(f.Value)
+		if err != nil {
+			return fmt.Errorf("invalid duration '%s' for filter type %s: %w", f.Value, f.Type, err)
+		}
+
+	case FilterTypeDateBefore, FilterTypeDateAfter:
+		_, err := parseDate(f.Value)
+		if err != nil {
+			return fmt.Errorf("invalid date '%s' for filter type %s: %w", f.Value, f.Type, err)
+		}
+
+	case FilterTypeNumberGreaterThan, FilterTypeNumberLessThan:
+		_, err := strconv.ParseFloat(f.Value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid number '%s' for filter type %s", f.Value, f.Type)
+		}
+	}
+
 	return nil
 }
 
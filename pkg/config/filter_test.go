@@ -68,6 +68,31 @@ func TestUnmarshalFilter(t *testing.T) {
 				past.Format(time.RFC3339),
 			},
 		},
+		{
+			yaml:     `{"type":"dateNewerThan","value":"1h"}`,
+			match:    []string{past.Format(time.RFC3339)},
+			mismatch: []string{"", future.Format(time.RFC3339)},
+		},
+		{
+			yaml:     `{"type":"dateAfter","value":"2023-03-01T12:00:00Z"}`,
+			match:    []string{"2023-03-02", "2023-03-01T12:00:01Z"},
+			mismatch: []string{"", "2023-03-01", "2023-03-01T12:00:00Z"},
+		},
+		{
+			yaml:     `{"type":"dateBefore","value":"2023-03-01"}`,
+			match:    []string{"2023-02-28", "2023-02-28T23:59:59Z"},
+			mismatch: []string{"", "2023-03-01", "2023-03-02"},
+		},
+		{
+			yaml:     `{"type":"numberGreaterThan","value":"128"}`,
+			match:    []string{"129", "1024", "128.5"},
+			mismatch: []string{"", "128", "-1"},
+		},
+		{
+			yaml:     `{"type":"numberLessThan","value":"1e3"}`,
+			match:    []string{"0", "999", "-5"},
+			mismatch: []string{"", "1000", "5000"},
+		},
 	}
 
 	for _, tc := range cases {
@@ -140,3 +165,81 @@ func TestDateParseErrors(t *testing.T) {
 		}
 	})
 }
+
+func TestUnmarshalFilterValidation(t *testing.T) {
+	cases := []string{
+		`{"type":"numberGreaterThan","value":"lots"}`,
+		`{"type":"numberLessThan","value":""}`,
+		`{"type":"dateOlderThan","value":"3 days"}`,
+		`{"type":"dateNewerThan","value":"3 days"}`,
+		`{"type":"dateBefore","value":"yesterday"}`,
+		`{"type":"dateAfter","value":"2023-13-45"}`,
+		`{"all":[{"type":"numberGreaterThan","value":"x"}]}`,
+	}
+
+	for _, tc := range cases {
+		t.Run(tc, func(t *testing.T) {
+			var filter config.Filter
+			err := yaml.Unmarshal([]byte(tc), &filter)
+			if err == nil {
+				t.Fatalf("Expected validation error.")
+			}
+		})
+	}
+}
+
+func TestNumberFilterParseError(t *testing.T) {
+	filter := config.Filter{
+		Type:  config.FilterTypeNumberGreaterThan,
+		Value: "10",
+	}
+
+	_, err := filter.Match("ten", &config.Nuke{})
+	if err == nil {
+		t.Fatal("Expected error for a property which is not a number.")
+	}
+}
+
+func TestUnmarshalCompositeFilter(t *testing.T) {
+	var filter config.Filter
+	err := yaml.Unmarshal([]byte(`
+all:
+- property: tag:team
+  value: platform
+- not:
+    property: tag:owner
+    type: exists
+invert: true
+`), &filter)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !filter.IsComposite() || len(filter.All) != 2 || filter.Invert != "true" {
+		t.Fatalf("Wrong filter: %#v", filter)
+	}
+
+	if filter.All[1].Not == nil || filter.All[1].Not.Type != config.FilterTypeExists {
+		t.Fatalf("Wrong nested filter: %#v", filter.All[1])
+	}
+
+	invalid := []string{
+		`{"all":[{"value":"foo"}],"any":[{"value":"bar"}]}`,
+		`{"all":[{"value":"foo"}],"property":"Name"}`,
+		`{"not":{"value":"foo"},"type":"glob"}`,
+		`{"all":[]}`,
+		`{"any":[]}`,
+		`{"any":null}`,
+		`{"not":{"all":[]}}`,
+	}
+
+	for _, tc := range invalid {
+		t.Run(tc, func(t *testing.T) {
+			var filter config.Filter
+			err := yaml.Unmarshal([]byte(tc), &filter)
+			if err == nil {
+				t.Fatalf("Expected an error for invalid filter.")
+			}
+		})
+	}
+}
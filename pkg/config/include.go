@@ -0,0 +1,255 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// IncludeKey is the top level key of a config file, which lists other YAML
+// files that get merged into it. The paths are relative to the including
+// file and may contain globs.
+const IncludeKey = "include"
+
+// reEnvVar matches ${NAME} and the escaped form $${NAME}, which stays a
+// literal ${NAME}.
+var reEnvVar = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+// loadNode reads a config file with all its includes and returns the merged
+// YAML tree. The stack contains the files which are currently loaded, to
+// detect include cycles.
+func loadNode(path string, stack []string) (*yaml.Node, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, loading := range stack {
+		if loading == abs {
+			return nil, fmt.Errorf("include cycle: %s -> %s", strings.Join(stack, " -> "), abs)
+		}
+	}
+	stack = append(stack, abs)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	document := new(yaml.Node)
+	err = yaml.Unmarshal(raw, document)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	root := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	if document.Kind == yaml.DocumentNode && len(document.Content) > 0 {
+		root = document.Content[0]
+	}
+
+	if root.Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s: the config must be a mapping", path)
+	}
+
+	interpolated, err := interpolateEnv(root)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	// The line numbers only match the file, if nothing was replaced.
+	if interpolated {
+		raw, err = yaml.Marshal(root)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err = checkKnownFields(raw)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	includes, err := popIncludes(root)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	merged := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for _, pattern := range includes {
+		paths, err := resolveInclude(filepath.Dir(path), pattern)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+
+		for _, included := range paths {
+			node, err := loadNode(included, stack)
+			if err != nil {
+				return nil, err
+			}
+
+			err = mergeNodes(merged, node)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", included, err)
+			}
+		}
+	}
+
+	err = mergeNodes(merged, root)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return merged, nil
+}
+
+// interpolateEnv replaces ${NAME} in all keys and values with the value of
+// the environment variable. Undefined variables are an error, since an empty
+// account ID or filter value would silently change what gets removed. It
+// returns true, if anything was replaced.
+func interpolateEnv(node *yaml.Node) (bool, error) {
+	interpolated := false
+
+	if node.Kind == yaml.ScalarNode && reEnvVar.MatchString(node.Value) {
+		var missing []string
+		interpolated = true
+
+		node.Value = reEnvVar.ReplaceAllStringFunc(node.Value, func(match string) string {
+			if strings.HasPrefix(match, "$$") {
+				return match[1:]
+			}
+
+			name := reEnvVar.FindStringSubmatch(match)[1]
+			value, ok := os.LookupEnv(name)
+			if !ok {
+				missing = append(missing, name)
+			}
+			return value
+		})
+
+		if len(missing) > 0 {
+			return false, fmt.Errorf("line %d: environment variable %s is not defined",
+				node.Line, strings.Join(missing, ", "))
+		}
+
+		// Unquoted values get their type resolved again, so numbers from
+		// the environment can be used for numeric fields.
+		if node.Style&(yaml.SingleQuotedStyle|yaml.DoubleQuotedStyle|yaml.LiteralStyle|yaml.FoldedStyle) == 0 {
+			node.Tag = ""
+		}
+	}
+
+	for _, child := range node.Content {
+		replaced, err := interpolateEnv(child)
+		if err != nil {
+			return false, err
+		}
+		interpolated = interpolated || replaced
+	}
+
+	return interpolated, nil
+}
+
+// checkKnownFields decodes a single file strictly, so typos in keys are
+// reported with the file they are in.
+func checkKnownFields(raw []byte) error {
+	dec := yaml.NewDecoder(bytes.NewReader(raw))
+	dec.KnownFields(true)
+	return dec.Decode(new(Nuke))
+}
+
+func popIncludes(root *yaml.Node) ([]string, error) {
+	for i := 0; i < len(root.Content); i += 2 {
+		if root.Content[i].Value != IncludeKey {
+			continue
+		}
+
+		var includes []string
+		err := root.Content[i+1].Decode(&includes)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s must be a list of paths: %w",
+				root.Content[i].Line, IncludeKey, err)
+		}
+
+		root.Content = append(root.Content[:i], root.Content[i+2:]...)
+		return includes, nil
+	}
+
+	return nil, nil
+}
+
+func resolveInclude(dir, pattern string) ([]string, error) {
+	if !filepath.IsAbs(pattern) {
+		pattern = filepath.Join(dir, pattern)
+	}
+
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid include pattern %s: %w", pattern, err)
+	}
+
+	// A glob may match nothing, eg an empty directory of presets, but a
+	// plain path must exist.
+	if len(paths) == 0 && !strings.ContainsAny(pattern, "*?[") {
+		return nil, fmt.Errorf("included file %s does not exist", pattern)
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// mergeNodes merges src into dst. Mappings are merged key by key and lists
+// are concatenated, so included presets and filters add up. Empty values of
+// src, like an account without anything below it, keep the one in dst. Any
+// other scalar of src replaces the one in dst, but a mapping or list cannot
+// be replaced by a value of another kind, since this would silently drop
+// included filters.
+func mergeNodes(dst, src *yaml.Node) error {
+	for i := 0; i < len(src.Content); i += 2 {
+		key, value := src.Content[i], src.Content[i+1]
+
+		existing := mappingValue(dst, key.Value)
+		switch {
+		case existing == nil:
+			dst.Content = append(dst.Content, key, value)
+
+		case value.ShortTag() == "!!null":
+			if existing.Kind == yaml.ScalarNode {
+				*existing = *value
+			}
+
+		case existing.Kind == yaml.MappingNode && value.Kind == yaml.MappingNode:
+			err := mergeNodes(existing, value)
+			if err != nil {
+				return err
+			}
+
+		case existing.Kind == yaml.SequenceNode && value.Kind == yaml.SequenceNode:
+			existing.Content = append(existing.Content, value.Content...)
+
+		case existing.Kind != value.Kind:
+			return fmt.Errorf("line %d: %s cannot be merged with the included value of another kind",
+				key.Line, key.Value)
+
+		default:
+			*existing = *value
+		}
+	}
+
+	return nil
+}
+
+func mappingValue(node *yaml.Node, key string) *yaml.Node {
+	for i := 0; i < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,281 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	dir := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		err = os.WriteFile(path, []byte(strings.TrimSpace(content)+"\n"), 0644)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return dir
+}
+
+func TestLoadInclude(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"presets/common.yaml": `
+presets:
+  common:
+    filters:
+      IAMRole:
+      - "OrganizationAccountAccessRole"
+`,
+		"presets/terraform.yaml": `
+presets:
+  terraform:
+    filters:
+      S3Bucket:
+      - type: glob
+        value: "my-statebucket-*"
+`,
+		"base.yaml": `
+include:
+- presets/*.yaml
+regions:
+- eu-west-1
+account-blocklist:
+- "1234567890"
+older-than: 24h
+`,
+		"config.yaml": `
+include:
+- base.yaml
+regions:
+- eu-central-1
+older-than: 48h
+accounts:
+  "555133742":
+    presets:
+    - common
+    - terraform
+presets:
+  common:
+    filters:
+      IAMRole:
+      - "admin"
+`,
+	})
+
+	config, err := Load(filepath.Join(dir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expect := Nuke{
+		AccountBlocklist: []string{"1234567890"},
+		Regions:          []string{"eu-west-1", "eu-central-1"},
+		OlderThan:        48 * time.Hour,
+		Accounts: map[string]Account{
+			"555133742": {
+				Presets: []string{"common", "terraform"},
+			},
+		},
+		Presets: map[string]PresetDefinitions{
+			"common": {
+				Filters: Filters{
+					"IAMRole": {
+						NewExactFilter("OrganizationAccountAccessRole"),
+						NewExactFilter("admin"),
+					},
+				},
+			},
+			"terraform": {
+				Filters: Filters{
+					"S3Bucket": {
+						{Type: FilterTypeGlob, Value: "my-statebucket-*"},
+					},
+				},
+			},
+		},
+		hash: config.Hash(),
+	}
+
+	if !reflect.DeepEqual(*config, expect) {
+		t.Errorf("Read struct mismatches:")
+		t.Errorf("  Got:      %#v", *config)
+		t.Errorf("  Expected: %#v", expect)
+	}
+}
+
+func TestLoadIncludeNull(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"config.yaml": `
+include:
+- other.yaml
+regions:
+- eu-west-1
+account-blocklist:
+- "1234567890"
+accounts:
+  "123456789012":
+  "210987654321": ~
+`,
+		"other.yaml": `
+accounts:
+  "123456789012":
+    filters:
+      IAMRole:
+      - admin
+  "210987654321":
+    presets:
+    - common
+presets:
+  common:
+    filters:
+      IAMUser:
+      - admin
+`,
+	})
+
+	config, err := Load(filepath.Join(dir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := Filters{"IAMRole": []Filter{{Type: FilterTypeExact, Value: "admin"}}}
+	if !reflect.DeepEqual(config.Accounts["123456789012"].Filters, want) {
+		t.Errorf("Wrong filters. Want: %#v. Have: %#v", want, config.Accounts["123456789012"].Filters)
+	}
+
+	if !reflect.DeepEqual(config.Accounts["210987654321"].Presets, []string{"common"}) {
+		t.Errorf("Wrong presets: %#v", config.Accounts["210987654321"].Presets)
+	}
+}
+
+func TestLoadIncludeErrors(t *testing.T) {
+	cases := []struct {
+		name  string
+		files map[string]string
+		err   string
+	}{
+		{
+			name: "missing",
+			files: map[string]string{
+				"config.yaml": "include:\n- missing.yaml",
+			},
+			err: "missing.yaml does not exist",
+		},
+		{
+			name: "cycle",
+			files: map[string]string{
+				"config.yaml": "include:\n- other.yaml",
+				"other.yaml":  "include:\n- config.yaml",
+			},
+			err: "include cycle",
+		},
+		{
+			name: "unknown-field",
+			files: map[string]string{
+				"config.yaml": "include:\n- other.yaml",
+				"other.yaml":  "regions:\n- eu-west-1\nfilter:\n  IAMRole: []",
+			},
+			err: "other.yaml: yaml: unmarshal errors:\n  line 3: field filter not found",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			dir := writeFiles(t, tc.files)
+
+			_, err := Load(filepath.Join(dir, "config.yaml"))
+			if err == nil || !strings.Contains(err.Error(), tc.err) {
+				t.Fatalf("Expected error containing %q, got %v", tc.err, err)
+			}
+		})
+	}
+}
+
+func TestLoadInterpolation(t *testing.T) {
+	t.Setenv("NUKE_TEST_ACCOUNT", "555133742")
+	t.Setenv("NUKE_TEST_STAGE", "staging")
+	t.Setenv("NUKE_TEST_LIMIT", "5")
+
+	dir := writeFiles(t, map[string]string{
+		"config.yaml": `
+service-removal-limits:
+  iam: ${NUKE_TEST_LIMIT}
+accounts:
+  ${NUKE_TEST_ACCOUNT}:
+    filters:
+      IAMRole:
+      - "${NUKE_TEST_STAGE}-admin"
+      - type: regex
+        value: '^$${literal}$'
+`,
+	})
+
+	config, err := Load(filepath.Join(dir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if config.ServiceRemovalLimits["iam"] != 5 {
+		t.Errorf("Wrong removal limit %d", config.ServiceRemovalLimits["iam"])
+	}
+
+	want := Filters{
+		"IAMRole": {
+			NewExactFilter("staging-admin"),
+			{Type: FilterTypeRegex, Value: `^${literal}$`},
+		},
+	}
+
+	if !reflect.DeepEqual(config.Accounts["555133742"].Filters, want) {
+		t.Errorf("Wrong filters %#v", config.Accounts)
+	}
+}
+
+func TestLoadInterpolationUndefined(t *testing.T) {
+	os.Unsetenv("NUKE_TEST_UNDEFINED")
+
+	dir := writeFiles(t, map[string]string{
+		"config.yaml": "regions:\n- eu-west-1\n- ${NUKE_TEST_UNDEFINED}",
+	})
+
+	_, err := Load(filepath.Join(dir, "config.yaml"))
+	want := "config.yaml: line 3: environment variable NUKE_TEST_UNDEFINED is not defined"
+	if err == nil || !strings.Contains(err.Error(), want) {
+		t.Fatalf("Expected error containing %q, got %v", want, err)
+	}
+}
+
+func TestLoadHash(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"config.yaml":  "include:\n- regions.yaml",
+		"regions.yaml": "regions:\n- ${NUKE_TEST_REGION}",
+	})
+
+	t.Setenv("NUKE_TEST_REGION", "eu-west-1")
+	first, err := Load(filepath.Join(dir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("NUKE_TEST_REGION", "eu-central-1")
+	second, err := Load(filepath.Join(dir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if first.Hash() == "" || first.Hash() == second.Hash() {
+		t.Errorf("Expected different hashes, got %q and %q", first.Hash(), second.Hash())
+	}
+}
@@ -0,0 +1,161 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// FilterOrigin tells where a filter is defined in the config. Either Account
+// or Preset is set.
+type FilterOrigin struct {
+	Account string
+	Preset  string
+
+	// Region is only set for filters, which apply to a single region.
+	Region string
+
+	ResourceType string
+	Index        int
+}
+
+// String returns the path of the filter in the config, in the same form
+// which is used by 'config validate'.
+func (o FilterOrigin) String() string {
+	path := "accounts." + o.Account
+	if o.Preset != "" {
+		path = "presets." + o.Preset
+	}
+
+	if o.Region != "" {
+		path += ".regions." + o.Region
+	}
+
+	return fmt.Sprintf("%s.filters.%s[%d]", path, o.ResourceType, o.Index)
+}
+
+// FilterWithOrigin is a filter together with the place it is defined at.
+type FilterWithOrigin struct {
+	Filter
+	Origin FilterOrigin
+}
+
+// Describe returns the origin and the rule of the filter, eg
+// `presets.common.filters.IAMRole[0] (property tag:Name, type glob, value "ci-*")`.
+func (f FilterWithOrigin) Describe() string {
+	if f.IsComposite() {
+		return f.Origin.String() + " (composite)"
+	}
+
+	property := f.Property
+	if property == "" {
+		property = "name"
+	}
+
+	filterType := f.Type
+	if filterType == FilterTypeEmpty {
+		filterType = FilterTypeExact
+	}
+
+	rule := []string{
+		"property " + property,
+		"type " + string(filterType),
+		fmt.Sprintf("value %q", f.Value),
+	}
+
+	if f.Invert != "" {
+		rule = append(rule, "invert "+f.Invert)
+	}
+
+	return fmt.Sprintf("%s (%s)", f.Origin, strings.Join(rule, ", "))
+}
+
+// FiltersWithOrigin returns the same filters like RegionFilters, but keeps
+// track of where each of them is defined.
+func (c *Nuke) FiltersWithOrigin(accountID, region string) (map[string][]FilterWithOrigin, error) {
+	all, err := c.collectFilters(accountID, func(r string) bool {
+		return r == region
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	filters := map[string][]FilterWithOrigin{}
+	for _, filter := range all {
+		resourceType := filter.Origin.ResourceType
+		filters[resourceType] = append(filters[resourceType], filter)
+	}
+
+	return filters, nil
+}
+
+// AllFiltersWithOrigin returns every filter of the account and its presets,
+// including the ones of all regions.
+func (c *Nuke) AllFiltersWithOrigin(accountID string) ([]FilterWithOrigin, error) {
+	return c.collectFilters(accountID, func(string) bool {
+		return true
+	})
+}
+
+// collectFilters returns the account wide filters of the account and its
+// presets, followed by the ones of the matching regions.
+func (c *Nuke) collectFilters(accountID string, matchRegion func(string) bool) ([]FilterWithOrigin, error) {
+	account := c.Accounts[accountID]
+
+	for _, presetName := range account.Presets {
+		if _, ok := c.Presets[presetName]; !ok {
+			return nil, fmt.Errorf("Could not find filter preset '%s'", presetName)
+		}
+	}
+
+	result := []FilterWithOrigin{}
+	add := func(origin FilterOrigin, filters Filters) {
+		for _, resourceType := range sortedFilterKeys(filters) {
+			for i, filter := range filters[resourceType] {
+				origin.ResourceType = resourceType
+				origin.Index = i
+				result = append(result, FilterWithOrigin{Filter: filter, Origin: origin})
+			}
+		}
+	}
+
+	add(FilterOrigin{Account: accountID}, account.Filters)
+	for _, presetName := range account.Presets {
+		add(FilterOrigin{Preset: presetName}, c.Presets[presetName].Filters)
+	}
+
+	for _, region := range sortedRegionKeys(account.Regions) {
+		if matchRegion(region) {
+			add(FilterOrigin{Account: accountID, Region: region}, account.Regions[region].Filters)
+		}
+	}
+
+	for _, presetName := range account.Presets {
+		regions := c.Presets[presetName].Regions
+		for _, region := range sortedRegionKeys(regions) {
+			if matchRegion(region) {
+				add(FilterOrigin{Preset: presetName, Region: region}, regions[region].Filters)
+			}
+		}
+	}
+
+	return result, nil
+}
+
+func sortedFilterKeys(filters Filters) []string {
+	keys := make([]string, 0, len(filters))
+	for key := range filters {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedRegionKeys(regions map[string]RegionConfig) []string {
+	keys := make([]string, 0, len(regions))
+	for key := range regions {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
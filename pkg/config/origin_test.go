@@ -0,0 +1,101 @@
+package config
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFiltersWithOrigin(t *testing.T) {
+	c := &Nuke{
+		Presets: map[string]PresetDefinitions{
+			"common": {
+				Filters: Filters{
+					"IAMRole": {NewExactFilter("OrganizationAccountAccessRole")},
+				},
+				Regions: map[string]RegionConfig{
+					"eu-central-1": {Filters: Filters{
+						"EC2VPC": {{Property: "tag:Name", Type: FilterTypeGlob, Value: "shared-*", Invert: "true"}},
+					}},
+				},
+			},
+		},
+		Accounts: map[string]Account{
+			"555133742": {
+				Presets: []string{"common"},
+				Filters: Filters{
+					"IAMRole": {NewExactFilter("admin")},
+					"IAMUser": {{Any: []Filter{NewExactFilter("ci")}}},
+				},
+				Regions: map[string]RegionConfig{
+					"eu-west-1": {Filters: Filters{
+						"EC2VPC": {NewExactFilter("vpc-1")},
+					}},
+				},
+			},
+		},
+	}
+
+	filters, err := c.FiltersWithOrigin("555133742", "eu-central-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	have := map[string][]string{}
+	for resourceType, list := range filters {
+		for _, filter := range list {
+			have[resourceType] = append(have[resourceType], filter.Describe())
+		}
+	}
+
+	want := map[string][]string{
+		"IAMRole": {
+			`accounts.555133742.filters.IAMRole[0] (property name, type exact, value "admin")`,
+			`presets.common.filters.IAMRole[0] (property name, type exact, value "OrganizationAccountAccessRole")`,
+		},
+		"IAMUser": {
+			`accounts.555133742.filters.IAMUser[0] (composite)`,
+		},
+		"EC2VPC": {
+			`presets.common.regions.eu-central-1.filters.EC2VPC[0] (property tag:Name, type glob, value "shared-*", invert true)`,
+		},
+	}
+
+	if !reflect.DeepEqual(want, have) {
+		t.Errorf("Wrong filters.\nWant: %#v\nHave: %#v", want, have)
+	}
+
+	all, err := c.AllFiltersWithOrigin("555133742")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	paths := []string{}
+	for _, filter := range all {
+		paths = append(paths, filter.Origin.String())
+	}
+
+	wantPaths := []string{
+		"accounts.555133742.filters.IAMRole[0]",
+		"accounts.555133742.filters.IAMUser[0]",
+		"presets.common.filters.IAMRole[0]",
+		"accounts.555133742.regions.eu-west-1.filters.EC2VPC[0]",
+		"presets.common.regions.eu-central-1.filters.EC2VPC[0]",
+	}
+
+	if !reflect.DeepEqual(wantPaths, paths) {
+		t.Errorf("Wrong filters.\nWant: %#v\nHave: %#v", wantPaths, paths)
+	}
+}
+
+func TestFiltersWithOriginMissingPreset(t *testing.T) {
+	c := &Nuke{
+		Accounts: map[string]Account{
+			"555133742": {Presets: []string{"missing"}},
+		},
+	}
+
+	_, err := c.FiltersWithOrigin("555133742", "eu-west-1")
+	if err == nil || err.Error() != "Could not find filter preset 'missing'" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
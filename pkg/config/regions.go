@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+)
+
+const (
+	// RegionsAll expands to every region of the partition. Regions which are
+	// not enabled for the account are skipped with a warning, since they
+	// cannot contain any resources.
+	RegionsAll = "all"
+
+	// RegionsEnabled expands to every region which is enabled for the
+	// account, ie which does not require an opt-in or is opted in.
+	RegionsEnabled = "enabled"
+
+	// regionExcludePrefix marks a glob pattern of regions which are removed
+	// from the list, eg "!ap-*".
+	regionExcludePrefix = "!"
+)
+
+// RegionStatus is a region which was discovered at runtime.
+type RegionStatus struct {
+	Name    string
+	Enabled bool
+}
+
+// HasRegionKeywords returns true, if the regions contain 'all' or 'enabled'
+// and therefore have to be discovered at runtime.
+func (c *Nuke) HasRegionKeywords() bool {
+	for _, region := range c.Regions {
+		if region == RegionsAll || region == RegionsEnabled {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ResolveRegions expands the keywords with the discovered regions and
+// removes the excluded ones. Explicitly listed regions keep their order and
+// come first, the expanded ones follow sorted by name. It also returns the
+// regions of 'all' which were skipped, because they are not enabled.
+func (c *Nuke) ResolveRegions(discovered []RegionStatus) ([]string, []string) {
+	var (
+		explicit, excludes []string
+		all, enabled       bool
+	)
+
+	for _, region := range c.Regions {
+		switch {
+		case region == RegionsAll:
+			all = true
+		case region == RegionsEnabled:
+			enabled = true
+		case strings.HasPrefix(region, regionExcludePrefix):
+			excludes = append(excludes, strings.TrimPrefix(region, regionExcludePrefix))
+		default:
+			explicit = append(explicit, region)
+		}
+	}
+
+	expanded := []string{}
+	skipped := []string{}
+	if all || enabled {
+		sorted := append([]RegionStatus{}, discovered...)
+		sort.Slice(sorted, func(i, j int) bool {
+			return sorted[i].Name < sorted[j].Name
+		})
+
+		for _, region := range sorted {
+			if region.Enabled {
+				expanded = append(expanded, region.Name)
+			} else if all && !isRegionExcluded(region.Name, excludes) {
+				skipped = append(skipped, region.Name)
+			}
+		}
+	}
+
+	seen := map[string]bool{}
+	regions := []string{}
+	for _, region := range append(explicit, expanded...) {
+		if seen[region] || isRegionExcluded(region, excludes) {
+			continue
+		}
+		seen[region] = true
+		regions = append(regions, region)
+	}
+
+	return regions, skipped
+}
+
+func isRegionExcluded(region string, excludes []string) bool {
+	for _, pattern := range excludes {
+		// The patterns are validated on load.
+		match, _ := path.Match(pattern, region)
+		if match {
+			return true
+		}
+	}
+
+	return false
+}
+
+func validateRegions(regions []string) error {
+	for _, region := range regions {
+		if !strings.HasPrefix(region, regionExcludePrefix) {
+			continue
+		}
+
+		pattern := strings.TrimPrefix(region, regionExcludePrefix)
+		_, err := path.Match(pattern, "")
+		if pattern == "" || err != nil {
+			return fmt.Errorf("invalid region exclude pattern '%s'", region)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package config
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestResolveRegions(t *testing.T) {
+	discovered := []RegionStatus{
+		{Name: "us-east-1", Enabled: true},
+		{Name: "ap-southeast-1", Enabled: true},
+		{Name: "eu-west-1", Enabled: true},
+		{Name: "ap-east-1", Enabled: false},
+		{Name: "me-south-1", Enabled: false},
+	}
+
+	cases := []struct {
+		name        string
+		regions     []string
+		wantRegions []string
+		wantSkipped []string
+	}{
+		{
+			name:        "explicit",
+			regions:     []string{"global", "eu-west-1"},
+			wantRegions: []string{"global", "eu-west-1"},
+			wantSkipped: []string{},
+		},
+		{
+			name:        "enabled",
+			regions:     []string{"global", "enabled"},
+			wantRegions: []string{"global", "ap-southeast-1", "eu-west-1", "us-east-1"},
+			wantSkipped: []string{},
+		},
+		{
+			name:        "all",
+			regions:     []string{"all", "eu-west-1"},
+			wantRegions: []string{"eu-west-1", "ap-southeast-1", "us-east-1"},
+			wantSkipped: []string{"ap-east-1", "me-south-1"},
+		},
+		{
+			name:        "excludes",
+			regions:     []string{"global", "all", "!ap-*", "!us-east-1"},
+			wantRegions: []string{"global", "eu-west-1"},
+			wantSkipped: []string{"me-south-1"},
+		},
+		{
+			name:        "exclude explicit",
+			regions:     []string{"global", "eu-west-1", "!global"},
+			wantRegions: []string{"eu-west-1"},
+			wantSkipped: []string{},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := &Nuke{Regions: tc.regions}
+
+			regions, skipped := c.ResolveRegions(discovered)
+			if !reflect.DeepEqual(regions, tc.wantRegions) {
+				t.Errorf("Wrong regions.\nWant: %#v\nHave: %#v", tc.wantRegions, regions)
+			}
+			if !reflect.DeepEqual(skipped, tc.wantSkipped) {
+				t.Errorf("Wrong skipped regions.\nWant: %#v\nHave: %#v", tc.wantSkipped, skipped)
+			}
+		})
+	}
+}
+
+func TestHasRegionKeywords(t *testing.T) {
+	if (&Nuke{Regions: []string{"eu-west-1", "!ap-*"}}).HasRegionKeywords() {
+		t.Errorf("Expected no keywords in explicit regions")
+	}
+
+	if !(&Nuke{Regions: []string{"global", "enabled"}}).HasRegionKeywords() {
+		t.Errorf("Expected 'enabled' to be a keyword")
+	}
+}
+
+func TestLoadInvalidRegionExclude(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"config.yaml": "regions:\n- all\n- '!ap-[*'",
+	})
+
+	_, err := Load(filepath.Join(dir, "config.yaml"))
+	if err == nil || err.Error() != "invalid region exclude pattern '!ap-[*'" {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestRegionFilters(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"config.yaml": `
+regions:
+- global
+- eu-central-1
+- eu-west-1
+
+presets:
+  shared-vpc:
+    regions:
+      eu-central-1:
+        filters:
+          EC2VPC:
+          - property: tag:Name
+            value: shared
+        resource-types:
+          excludes:
+          - EC2DHCPOption
+
+accounts:
+  "555133742":
+    presets:
+    - shared-vpc
+    filters:
+      IAMRole:
+      - admin
+    regions:
+      global:
+        filters:
+          IAMUser:
+          - ci
+      eu-central-1:
+        resource-types:
+          targets:
+          - EC2VPC
+          - EC2Subnet
+`,
+	})
+
+	config, err := Load(filepath.Join(dir, "config.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cases := []struct {
+		region string
+		want   Filters
+	}{
+		{"eu-west-1", Filters{
+			"IAMRole": {NewExactFilter("admin")},
+		}},
+		{"global", Filters{
+			"IAMRole": {NewExactFilter("admin")},
+			"IAMUser": {NewExactFilter("ci")},
+		}},
+		{"eu-central-1", Filters{
+			"IAMRole": {NewExactFilter("admin")},
+			"EC2VPC":  {{Property: "tag:Name", Value: "shared"}},
+		}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.region, func(t *testing.T) {
+			filters, err := config.RegionFilters("555133742", tc.region)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if !reflect.DeepEqual(filters, tc.want) {
+				t.Errorf("Wrong filters.\nWant: %#v\nHave: %#v", tc.want, filters)
+			}
+		})
+	}
+
+	want := []ResourceTypes{
+		{Targets: []string{"EC2VPC", "EC2Subnet"}},
+		{Excludes: []string{"EC2DHCPOption"}},
+	}
+	if have := config.RegionResourceTypes("555133742", "eu-central-1"); !reflect.DeepEqual(have, want) {
+		t.Errorf("Wrong resource types.\nWant: %#v\nHave: %#v", want, have)
+	}
+
+	// The account wide filters must not be modified by merging the region.
+	if len(config.Accounts["555133742"].Filters) != 1 {
+		t.Errorf("Account filters were modified: %#v", config.Accounts["555133742"].Filters)
+	}
+}
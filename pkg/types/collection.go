@@ -41,6 +41,16 @@ func (c Collection) Union(o Collection) Collection {
 	return Collection(result)
 }
 
+func (c Collection) Contains(e string) bool {
+	for _, t := range c {
+		if t == e {
+			return true
+		}
+	}
+
+	return false
+}
+
 func (c Collection) toMap() map[string]bool {
 	m := map[string]bool{}
 	for _, t := range c {
@@ -48,3 +48,15 @@ func TestSetUnion(t *testing.T) {
 		t.Errorf("Wrong result. Want: %s. Have: %s", want, have)
 	}
 }
+
+func TestSetContains(t *testing.T) {
+	s := types.Collection{"a", "b", "c"}
+
+	if !s.Contains("b") {
+		t.Errorf("Contains() returned false for an existing element.")
+	}
+
+	if s.Contains("d") {
+		t.Errorf("Contains() returned true for a missing element.")
+	}
+}
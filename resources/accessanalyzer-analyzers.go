@@ -17,7 +17,8 @@ type AccessAnalyzer struct {
 
 func init() {
 	register("AccessAnalyzer", ListAccessAnalyzer,
-		mapCloudControl("AWS::AccessAnalyzer::Analyzer"))
+		mapCloudControl("AWS::AccessAnalyzer::Analyzer"),
+		withProperties("ARN", "Name", "Status", "tag:*"))
 }
 
 func ListAccessAnalyzer(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type ArchiveRule struct {
 }
 
 func init() {
-	register("ArchiveRule", ListArchiveRule)
+	register("ArchiveRule", ListArchiveRule,
+		withProperties("RuleName", "AnalyzerName"))
 }
 
 func ListArchiveRule(sess *session.Session) ([]Resource, error) {
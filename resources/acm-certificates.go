@@ -15,7 +15,8 @@ type ACMCertificate struct {
 }
 
 func init() {
-	register("ACMCertificate", ListACMCertificates)
+	register("ACMCertificate", ListACMCertificates,
+		withProperties("DomainName", "tag:*"))
 }
 
 func ListACMCertificates(sess *session.Session) ([]Resource, error) {
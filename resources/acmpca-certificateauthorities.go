@@ -18,7 +18,9 @@ type ACMPCACertificateAuthority struct {
 
 func init() {
 	register("ACMPCACertificateAuthority", ListACMPCACertificateAuthorities,
-		mapCloudControl("AWS::ACMPCA::CertificateAuthority"))
+		mapCloudControl("AWS::ACMPCA::CertificateAuthority"),
+		withProperties("ARN", "Status", "tag:*"),
+		withFilter())
 }
 
 func ListACMPCACertificateAuthorities(sess *session.Session) ([]Resource, error) {
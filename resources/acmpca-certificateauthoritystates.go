@@ -17,7 +17,9 @@ type ACMPCACertificateAuthorityState struct {
 }
 
 func init() {
-	register("ACMPCACertificateAuthorityState", ListACMPCACertificateAuthorityStates)
+	register("ACMPCACertificateAuthorityState", ListACMPCACertificateAuthorityStates,
+		withProperties("ARN", "Status", "tag:*"),
+		withFilter())
 }
 
 func ListACMPCACertificateAuthorityStates(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type APIGatewayRestAPI struct {
 }
 
 func init() {
-	register("APIGatewayRestAPI", ListAPIGatewayRestApis)
+	register("APIGatewayRestAPI", ListAPIGatewayRestApis,
+		withProperties("APIID", "Name", "Version", "tag:*"))
 }
 
 func ListAPIGatewayRestApis(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type APIGatewayUsagePlan struct {
 
 func init() {
 	register("APIGatewayUsagePlan", ListAPIGatewayUsagePlans,
-		mapCloudControl("AWS::ApiGateway::UsagePlan"))
+		mapCloudControl("AWS::ApiGateway::UsagePlan"),
+		withProperties("UsagePlanID", "Name", "tag:*"))
 }
 
 func ListAPIGatewayUsagePlans(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type APIGatewayVpcLink struct {
 }
 
 func init() {
-	register("APIGatewayVpcLink", ListAPIGatewayVpcLinks)
+	register("APIGatewayVpcLink", ListAPIGatewayVpcLinks,
+		withProperties("VPCLinkID", "Name", "tag:*"))
 }
 
 func ListAPIGatewayVpcLinks(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type APIGatewayV2API struct {
 }
 
 func init() {
-	register("APIGatewayV2API", ListAPIGatewayV2APIs)
+	register("APIGatewayV2API", ListAPIGatewayV2APIs,
+		withProperties("APIID", "Name", "ProtocolType", "Version", "tag:*"))
 }
 
 func ListAPIGatewayV2APIs(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type APIGatewayV2VpcLink struct {
 }
 
 func init() {
-	register("APIGatewayV2VpcLink", ListAPIGatewayV2VpcLinks)
+	register("APIGatewayV2VpcLink", ListAPIGatewayV2VpcLinks,
+		withProperties("VPCLinkID", "Name", "tag:*"))
 }
 
 func ListAPIGatewayV2VpcLinks(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type AppConfigApplication struct {
 }
 
 func init() {
-	register("AppConfigApplication", ListAppConfigApplications)
+	register("AppConfigApplication", ListAppConfigApplications,
+		withProperties("ID", "Name"))
 }
 
 func ListAppConfigApplications(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type AppConfigConfigurationProfile struct {
 }
 
 func init() {
-	register("AppConfigConfigurationProfile", ListAppConfigConfigurationProfiles)
+	register("AppConfigConfigurationProfile", ListAppConfigConfigurationProfiles,
+		withProperties("ApplicationID", "ID", "Name"))
 }
 
 func ListAppConfigConfigurationProfiles(sess *session.Session) ([]Resource, error) {
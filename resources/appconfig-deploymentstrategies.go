@@ -17,7 +17,9 @@ type AppConfigDeploymentStrategy struct {
 }
 
 func init() {
-	register("AppConfigDeploymentStrategy", ListAppConfigDeploymentStrategies)
+	register("AppConfigDeploymentStrategy", ListAppConfigDeploymentStrategies,
+		withFilter(),
+		withProperties("ID", "Name"))
 }
 
 func ListAppConfigDeploymentStrategies(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type AppConfigEnvironment struct {
 }
 
 func init() {
-	register("AppConfigEnvironment", ListAppConfigEnvironments)
+	register("AppConfigEnvironment", ListAppConfigEnvironments,
+		withProperties("ApplicationID", "ID", "Name"))
 }
 
 func ListAppConfigEnvironments(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type AppConfigHostedConfigurationVersion struct {
 }
 
 func init() {
-	register("AppConfigHostedConfigurationVersion", ListAppConfigHostedConfigurationVersions)
+	register("AppConfigHostedConfigurationVersion", ListAppConfigHostedConfigurationVersions,
+		withProperties("ApplicationID", "ConfigurationProfileID", "VersionNumber"))
 }
 
 func ListAppConfigHostedConfigurationVersions(sess *session.Session) ([]Resource, error) {
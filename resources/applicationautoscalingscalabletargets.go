@@ -16,7 +16,8 @@ type AppAutoScaling struct {
 }
 
 func init() {
-	register("ApplicationAutoScalingScalableTarget", ListApplicationAutoScalingScalableTargets)
+	register("ApplicationAutoScalingScalableTarget", ListApplicationAutoScalingScalableTargets,
+		withProperties("ResourceID", "ScalableDimension", "ServiceNamespace"))
 }
 
 func ListApplicationAutoScalingScalableTargets(sess *session.Session) ([]Resource, error) {
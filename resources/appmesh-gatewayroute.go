@@ -14,7 +14,8 @@ type AppMeshGatewayRoute struct {
 }
 
 func init() {
-	register("AppMeshGatewayRoute", ListAppMeshGatewayRoutes)
+	register("AppMeshGatewayRoute", ListAppMeshGatewayRoutes,
+		withProperties("MeshName", "VirtualGatewayName", "Name"))
 }
 
 func ListAppMeshGatewayRoutes(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type AppMeshMesh struct {
 }
 
 func init() {
-	register("AppMeshMesh", ListAppMeshMeshes)
+	register("AppMeshMesh", ListAppMeshMeshes,
+		withProperties("MeshName"))
 }
 
 func ListAppMeshMeshes(sess *session.Session) ([]Resource, error) {
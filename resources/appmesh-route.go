@@ -14,7 +14,8 @@ type AppMeshRoute struct {
 }
 
 func init() {
-	register("AppMeshRoute", ListAppMeshRoutes)
+	register("AppMeshRoute", ListAppMeshRoutes,
+		withProperties("MeshName", "VirtualRouterName", "Name"))
 }
 
 func ListAppMeshRoutes(sess *session.Session) ([]Resource, error) {
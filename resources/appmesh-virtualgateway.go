@@ -13,7 +13,8 @@ type AppMeshVirtualGateway struct {
 }
 
 func init() {
-	register("AppMeshVirtualGateway", ListAppMeshVirtualGateways)
+	register("AppMeshVirtualGateway", ListAppMeshVirtualGateways,
+		withProperties("MeshName", "Name"))
 }
 
 func ListAppMeshVirtualGateways(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type AppMeshVirtualNode struct {
 }
 
 func init() {
-	register("AppMeshVirtualNode", ListAppMeshVirtualNodes)
+	register("AppMeshVirtualNode", ListAppMeshVirtualNodes,
+		withProperties("MeshName", "Name"))
 }
 
 func ListAppMeshVirtualNodes(sess *session.Session) ([]Resource, error) {
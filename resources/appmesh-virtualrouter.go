@@ -13,7 +13,8 @@ type AppMeshVirtualRouter struct {
 }
 
 func init() {
-	register("AppMeshVirtualRouter", ListAppMeshVirtualRouters)
+	register("AppMeshVirtualRouter", ListAppMeshVirtualRouters,
+		withProperties("MeshName", "Name"))
 }
 
 func ListAppMeshVirtualRouters(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type AppMeshVirtualService struct {
 }
 
 func init() {
-	register("AppMeshVirtualService", ListAppMeshVirtualServices)
+	register("AppMeshVirtualService", ListAppMeshVirtualServices,
+		withProperties("MeshName", "Name"))
 }
 
 func ListAppMeshVirtualServices(sess *session.Session) ([]Resource, error) {
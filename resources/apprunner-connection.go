@@ -13,7 +13,8 @@ type AppRunnerConnection struct {
 }
 
 func init() {
-	register("AppRunnerConnection", ListAppRunnerConnections)
+	register("AppRunnerConnection", ListAppRunnerConnections,
+		withProperties("ConnectionArn", "ConnectionName"))
 }
 
 func ListAppRunnerConnections(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type AppRunnerService struct {
 }
 
 func init() {
-	register("AppRunnerService", ListAppRunnerServices)
+	register("AppRunnerService", ListAppRunnerServices,
+		withProperties("ServiceArn", "ServiceId", "ServiceName"))
 }
 
 func ListAppRunnerServices(sess *session.Session) ([]Resource, error) {
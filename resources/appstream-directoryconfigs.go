@@ -17,7 +17,8 @@ type AppStreamDirectoryConfig struct {
 }
 
 func init() {
-	register("AppStreamDirectoryConfig", ListAppStreamDirectoryConfigs)
+	register("AppStreamDirectoryConfig", ListAppStreamDirectoryConfigs,
+		withProperties("Name", "CreatedTime", "InUse"))
 }
 
 func ListAppStreamDirectoryConfigs(sess *session.Session) ([]Resource, error) {
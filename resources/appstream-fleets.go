@@ -19,7 +19,8 @@ type AppStreamFleet struct {
 }
 
 func init() {
-	register("AppStreamFleet", ListAppStreamFleets)
+	register("AppStreamFleet", ListAppStreamFleets,
+		withProperties("Name", "State", "InstanceType", "FleetType", "CreatedTime", "tag:*"))
 }
 
 func ListAppStreamFleets(sess *session.Session) ([]Resource, error) {
@@ -20,7 +20,9 @@ type AppStreamFleetState struct {
 }
 
 func init() {
-	register("AppStreamFleetState", ListAppStreamFleetStates)
+	register("AppStreamFleetState", ListAppStreamFleetStates,
+		withProperties("Name", "State", "InstanceType", "FleetType", "CreatedTime", "tag:*"),
+		withFilter())
 }
 
 func ListAppStreamFleetStates(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,8 @@ type AppStreamImageBuilder struct {
 }
 
 func init() {
-	register("AppStreamImageBuilder", ListAppStreamImageBuilders)
+	register("AppStreamImageBuilder", ListAppStreamImageBuilders,
+		withProperties("Name", "State", "CreatedTime", "tag:*"))
 }
 
 func ListAppStreamImageBuilders(sess *session.Session) ([]Resource, error) {
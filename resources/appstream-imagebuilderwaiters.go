@@ -19,7 +19,9 @@ type AppStreamImageBuilderWaiter struct {
 }
 
 func init() {
-	register("AppStreamImageBuilderWaiter", ListAppStreamImageBuilderWaiters)
+	register("AppStreamImageBuilderWaiter", ListAppStreamImageBuilderWaiters,
+		withProperties("Name", "State", "CreatedTime", "tag:*"),
+		withFilter())
 }
 
 func ListAppStreamImageBuilderWaiters(sess *session.Session) ([]Resource, error) {
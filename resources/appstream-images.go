@@ -18,7 +18,9 @@ type AppStreamImage struct {
 }
 
 func init() {
-	register("AppStreamImage", ListAppStreamImages)
+	register("AppStreamImage", ListAppStreamImages,
+		withProperties("Name", "Visibility", "InUse"),
+		withFilter())
 }
 
 func ListAppStreamImages(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type AppStreamStackFleetAttachment struct {
 }
 
 func init() {
-	register("AppStreamStackFleetAttachment", ListAppStreamStackFleetAttachments)
+	register("AppStreamStackFleetAttachment", ListAppStreamStackFleetAttachments,
+		withProperties("StackName", "FleetName", "tag:stack:*", "tag:fleet:*"))
 }
 
 func ListAppStreamStackFleetAttachments(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type AppStreamStack struct {
 }
 
 func init() {
-	register("AppStreamStack", ListAppStreamStacks)
+	register("AppStreamStack", ListAppStreamStacks,
+		withProperties("Name", "CreatedTime", "tag:*"))
 }
 
 func ListAppStreamStacks(sess *session.Session) ([]Resource, error) {
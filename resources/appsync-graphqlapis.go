@@ -16,7 +16,8 @@ type AppSyncGraphqlAPI struct {
 }
 
 func init() {
-	register("AppSyncGraphqlAPI", ListAppSyncGraphqlAPIs)
+	register("AppSyncGraphqlAPI", ListAppSyncGraphqlAPIs,
+		withProperties("Name", "APIID", "tag:*"))
 }
 
 // ListAppSyncGraphqlAPIs - List all AWS AppSync GraphQL APIs in the account
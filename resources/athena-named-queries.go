@@ -8,7 +8,8 @@ import (
 
 func init() {
 	register("AthenaNamedQuery", ListAthenaNamedQueries,
-		mapCloudControl("AWS::Athena::NamedQuery"))
+		mapCloudControl("AWS::Athena::NamedQuery"),
+		withProperties("Id"))
 }
 
 type AthenaNamedQuery struct {
@@ -14,7 +14,9 @@ import (
 
 func init() {
 	register("AthenaWorkGroup", ListAthenaWorkGroups,
-		mapCloudControl("AWS::Athena::WorkGroup"))
+		mapCloudControl("AWS::Athena::WorkGroup"),
+		withFilter(),
+		withProperties("Name", "ARN"))
 }
 
 type AthenaWorkGroup struct {
@@ -10,7 +10,8 @@ import (
 )
 
 func init() {
-	register("AutoScalingGroup", ListAutoscalingGroups)
+	register("AutoScalingGroup", ListAutoscalingGroups,
+		withProperties("CreatedTime", "Name", "tag:*"))
 }
 
 func ListAutoscalingGroups(s *session.Session) ([]Resource, error) {
@@ -17,7 +17,9 @@ type BackupPlan struct {
 }
 
 func init() {
-	register("AWSBackupPlan", ListBackupPlans)
+	register("AWSBackupPlan", ListBackupPlans,
+		withProperties("ID", "Name", "tag:*"),
+		withFilter())
 }
 
 func ListBackupPlans(sess *session.Session) ([]Resource, error) {
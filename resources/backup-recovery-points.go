@@ -2,19 +2,24 @@ package resources
 
 import (
 	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
+	"github.com/sirupsen/logrus"
 )
 
 type BackupRecoveryPoint struct {
 	svc             *backup.Backup
 	arn             string
 	backupVaultName string
+	tags            map[string]*string
 }
 
 func init() {
-	register("AWSBackupRecoveryPoint", ListBackupRecoveryPoints)
+	register("AWSBackupRecoveryPoint", ListBackupRecoveryPoints,
+		withProperties("BackupVault", "tag:*"))
 }
 
 func ListBackupRecoveryPoints(sess *session.Session) ([]Resource, error) {
@@ -32,10 +37,24 @@ func ListBackupRecoveryPoints(sess *session.Session) ([]Resource, error) {
 	for _, out := range resp.BackupVaultList {
 		recoveryPointsOutput, _ := svc.ListRecoveryPointsByBackupVault(&backup.ListRecoveryPointsByBackupVaultInput{BackupVaultName: out.BackupVaultName})
 		for _, rp := range recoveryPointsOutput.RecoveryPoints {
+			// The tags mark final snapshots, which must be retained. Points
+			// which are not fully managed by AWS Backup, eg of EBS or RDS,
+			// might not support tags, so they are kept without them.
+			var tags map[string]*string
+			listed, err := svc.ListTags(&backup.ListTagsInput{
+				ResourceArn: rp.RecoveryPointArn,
+			})
+			if err != nil {
+				logrus.Warnf("Failed to list tags of recovery point %s: %v", *rp.RecoveryPointArn, err)
+			} else {
+				tags = listed.Tags
+			}
+
 			resources = append(resources, &BackupRecoveryPoint{
 				svc:             svc,
 				arn:             *rp.RecoveryPointArn,
 				backupVaultName: *out.BackupVaultName,
+				tags:            tags,
 			})
 		}
 	}
@@ -46,6 +65,9 @@ func ListBackupRecoveryPoints(sess *session.Session) ([]Resource, error) {
 func (b *BackupRecoveryPoint) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("BackupVault", b.backupVaultName)
+	for key, value := range b.tags {
+		properties.SetTag(aws.String(key), value)
+	}
 	return properties
 }
 
@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRecoveryPointsTags(t *testing.T) {
+	arn := "arn:aws:backup:eu-west-1:123456789012:recovery-point:final"
+	ebs := "arn:aws:ec2:eu-west-1::snapshot/snap-0123456789abcdef0"
+
+	server := awstest.NewServer(t)
+	server.Handle("GET /backup-vaults/",
+		awstest.JSON(`{"BackupVaultList": [{"BackupVaultName": "Default"}]}`))
+	server.Handle("GET /backup-vaults/Default/recovery-points/",
+		awstest.JSON(`{"RecoveryPoints": [{"RecoveryPointArn": "`+arn+`"}, {"RecoveryPointArn": "`+ebs+`"}]}`))
+	server.Handle("GET /tags/"+arn+"/",
+		awstest.JSON(`{"Tags": {"aws-nuke:retain-until": "2030-01-01T00:00:00Z"}}`))
+	server.Handle("GET /tags/"+ebs+"/",
+		awstest.Error(http.StatusBadRequest, "InvalidParameterValueException", "tags are not supported"))
+
+	resources, err := ListBackupRecoveryPoints(server.Session("backup"))
+	require.NoError(t, err)
+	// Failing tags of a single point do not hide the others.
+	require.Len(t, resources, 2)
+
+	properties := resources[0].(*BackupRecoveryPoint).Properties()
+	require.Equal(t, "Default", properties.Get("BackupVault"))
+	require.Equal(t, "2030-01-01T00:00:00Z", properties.Get("tag:aws-nuke:retain-until"))
+
+	properties = resources[1].(*BackupRecoveryPoint).Properties()
+	require.Equal(t, "", properties.Get("tag:aws-nuke:retain-until"))
+}
@@ -16,7 +16,9 @@ type BackupSelection struct {
 }
 
 func init() {
-	register("AWSBackupSelection", ListBackupSelections)
+	register("AWSBackupSelection", ListBackupSelections,
+		withProperties("Name", "ID", "PlanID"),
+		withFilter())
 }
 
 func ListBackupSelections(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,9 @@ type BackupVault struct {
 }
 
 func init() {
-	register("AWSBackupVault", ListBackupVaults)
+	register("AWSBackupVault", ListBackupVaults,
+		withProperties("Name", "tag:*"),
+		withFilter())
 }
 
 func ListBackupVaults(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type BatchComputeEnvironmentState struct {
 }
 
 func init() {
-	register("BatchComputeEnvironmentState", ListBatchComputeEnvironmentStates)
+	register("BatchComputeEnvironmentState", ListBatchComputeEnvironmentStates,
+		withFilter())
 }
 
 func ListBatchComputeEnvironmentStates(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type BatchJobQueueState struct {
 }
 
 func init() {
-	register("BatchJobQueueState", ListBatchJobQueueStates)
+	register("BatchJobQueueState", ListBatchJobQueueStates,
+		withFilter())
 }
 
 func ListBatchJobQueueStates(sess *session.Session) ([]Resource, error) {
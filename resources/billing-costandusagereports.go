@@ -8,7 +8,8 @@ import (
 )
 
 func init() {
-	register("BillingCostandUsageReport", ListBillingCostandUsageReports)
+	register("BillingCostandUsageReport", ListBillingCostandUsageReports,
+		withProperties("Name", "S3Bucket", "s3Prefix", "S3Region"))
 }
 
 type BillingCostandUsageReport struct {
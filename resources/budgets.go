@@ -11,7 +11,8 @@ import (
 )
 
 func init() {
-	register("Budget", ListBudgets)
+	register("Budget", ListBudgets,
+		withProperties("Name", "BudgetType", "AccountID"))
 }
 
 type Budget struct {
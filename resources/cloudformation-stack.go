@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"time"
@@ -18,7 +19,9 @@ import (
 const CLOUDFORMATION_MAX_DELETE_ATTEMPT = 3
 
 func init() {
-	register("CloudFormationStack", ListCloudFormationStacks)
+	register("CloudFormationStack", ListCloudFormationStacks,
+		withProperties("Name", "CreationTime", "LastUpdatedTime", "tag:*"),
+		withFeatureFlags("disable-deletion-protection.CloudformationStack", "cloudformation-execution-role"))
 }
 
 func ListCloudFormationStacks(sess *session.Session) ([]Resource, error) {
@@ -65,18 +68,22 @@ func (cfs *CloudFormationStack) FeatureFlags(ff config.FeatureFlags) {
 }
 
 func (cfs *CloudFormationStack) Remove() error {
-	return cfs.removeWithAttempts(0)
+	return cfs.RemoveWithContext(aws.BackgroundContext())
 }
 
-func (cfs *CloudFormationStack) removeWithAttempts(attempt int) error {
-	if err := cfs.doRemove(); err != nil {
+func (cfs *CloudFormationStack) RemoveWithContext(ctx context.Context) error {
+	return cfs.removeWithAttempts(ctx, 0)
+}
+
+func (cfs *CloudFormationStack) removeWithAttempts(ctx context.Context, attempt int) error {
+	if err := cfs.doRemove(ctx); err != nil {
 		logrus.Errorf("CloudFormationStack stackName=%s attempt=%d maxAttempts=%d delete failed: %s", *cfs.stack.StackName, attempt, cfs.maxDeleteAttempts, err.Error())
 		awsErr, ok := err.(awserr.Error)
 		if ok && awsErr.Code() == "ValidationError" &&
 			awsErr.Message() == "Stack ["+*cfs.stack.StackName+"] cannot be deleted while TerminationProtection is enabled" {
 			if cfs.featureFlags.DisableDeletionProtection.CloudformationStack {
 				logrus.Infof("CloudFormationStack stackName=%s attempt=%d maxAttempts=%d updating termination protection", *cfs.stack.StackName, attempt, cfs.maxDeleteAttempts)
-				_, err = cfs.svc.UpdateTerminationProtection(&cloudformation.UpdateTerminationProtectionInput{
+				_, err = cfs.svc.UpdateTerminationProtectionWithContext(ctx, &cloudformation.UpdateTerminationProtectionInput{
 					EnableTerminationProtection: aws.Bool(false),
 					StackName:                   cfs.stack.StackName,
 				})
@@ -88,18 +95,21 @@ func (cfs *CloudFormationStack) removeWithAttempts(attempt int) error {
 				return err
 			}
 		}
+		if ctx.Err() != nil {
+			return err
+		}
 		if attempt >= cfs.maxDeleteAttempts {
 			return errors.New("CFS might not be deleted after this run.")
 		} else {
-			return cfs.removeWithAttempts(attempt + 1)
+			return cfs.removeWithAttempts(ctx, attempt+1)
 		}
 	} else {
 		return nil
 	}
 }
 
-func (cfs *CloudFormationStack) doRemove() error {
-	o, err := cfs.svc.DescribeStacks(&cloudformation.DescribeStacksInput{
+func (cfs *CloudFormationStack) doRemove(ctx context.Context) error {
+	o, err := cfs.svc.DescribeStacksWithContext(ctx, &cloudformation.DescribeStacksInput{
 		StackName: cfs.stack.StackName,
 	})
 	if err != nil {
@@ -118,14 +128,14 @@ func (cfs *CloudFormationStack) doRemove() error {
 		return nil
 	} else if *stack.StackStatus == cloudformation.StackStatusDeleteInProgress {
 		logrus.Infof("CloudFormationStack stackName=%s delete in progress. Waiting", *cfs.stack.StackName)
-		return cfs.svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{
+		return cfs.svc.WaitUntilStackDeleteCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
 			StackName: cfs.stack.StackName,
 		})
 	} else if *stack.StackStatus == cloudformation.StackStatusDeleteFailed {
 		logrus.Infof("CloudFormationStack stackName=%s delete failed. Attempting to retain and delete stack", *cfs.stack.StackName)
 		// This means the CFS has undeleteable resources.
 		// In order to move on with nuking, we retain them in the deletion.
-		retainableResources, err := cfs.svc.ListStackResources(&cloudformation.ListStackResourcesInput{
+		retainableResources, err := cfs.svc.ListStackResourcesWithContext(ctx, &cloudformation.ListStackResourcesInput{
 			StackName: cfs.stack.StackName,
 		})
 		if err != nil {
@@ -140,7 +150,7 @@ func (cfs *CloudFormationStack) doRemove() error {
 			}
 		}
 
-		_, err = cfs.svc.DeleteStack(&cloudformation.DeleteStackInput{
+		_, err = cfs.svc.DeleteStackWithContext(ctx, &cloudformation.DeleteStackInput{
 			StackName:       cfs.stack.StackName,
 			RetainResources: retain,
 			RoleARN:         &cfs.featureFlags.CloudFormationExecutionRole,
@@ -148,18 +158,18 @@ func (cfs *CloudFormationStack) doRemove() error {
 		if err != nil {
 			return err
 		}
-		return cfs.svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{
+		return cfs.svc.WaitUntilStackDeleteCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
 			StackName: cfs.stack.StackName,
 		})
 	} else {
-		if err := cfs.waitForStackToStabilize(*stack.StackStatus); err != nil {
+		if err := cfs.waitForStackToStabilize(ctx, *stack.StackStatus); err != nil {
 			return err
-		} else if _, err := cfs.svc.DeleteStack(&cloudformation.DeleteStackInput{
+		} else if _, err := cfs.svc.DeleteStackWithContext(ctx, &cloudformation.DeleteStackInput{
 			StackName: cfs.stack.StackName,
 			RoleARN:   &cfs.featureFlags.CloudFormationExecutionRole,
 		}); err != nil {
 			return err
-		} else if err := cfs.svc.WaitUntilStackDeleteComplete(&cloudformation.DescribeStacksInput{
+		} else if err := cfs.svc.WaitUntilStackDeleteCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
 			StackName: cfs.stack.StackName,
 		}); err != nil {
 			return err
@@ -168,7 +178,7 @@ func (cfs *CloudFormationStack) doRemove() error {
 		}
 	}
 }
-func (cfs *CloudFormationStack) waitForStackToStabilize(currentStatus string) error {
+func (cfs *CloudFormationStack) waitForStackToStabilize(ctx context.Context, currentStatus string) error {
 	switch currentStatus {
 	case cloudformation.StackStatusUpdateInProgress:
 		fallthrough
@@ -176,14 +186,14 @@ func (cfs *CloudFormationStack) waitForStackToStabilize(currentStatus string) er
 		fallthrough
 	case cloudformation.StackStatusUpdateRollbackInProgress:
 		logrus.Infof("CloudFormationStack stackName=%s update in progress. Waiting to stabalize", *cfs.stack.StackName)
-		return cfs.svc.WaitUntilStackUpdateComplete(&cloudformation.DescribeStacksInput{
+		return cfs.svc.WaitUntilStackUpdateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
 			StackName: cfs.stack.StackName,
 		})
 	case cloudformation.StackStatusCreateInProgress:
 		fallthrough
 	case cloudformation.StackStatusRollbackInProgress:
 		logrus.Infof("CloudFormationStack stackName=%s create in progress. Waiting to stabalize", *cfs.stack.StackName)
-		return cfs.svc.WaitUntilStackCreateComplete(&cloudformation.DescribeStacksInput{
+		return cfs.svc.WaitUntilStackCreateCompleteWithContext(ctx, &cloudformation.DescribeStacksInput{
 			StackName: cfs.stack.StackName,
 		})
 	default:
@@ -208,6 +218,10 @@ func (cfs *CloudFormationStack) Properties() types.Properties {
 	return properties
 }
 
+func (cfs *CloudFormationStack) CreatedAt() *time.Time {
+	return cfs.stack.CreationTime
+}
+
 func (cfs *CloudFormationStack) String() string {
 	return *cfs.stack.StackName
 }
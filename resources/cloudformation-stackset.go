@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -15,7 +16,8 @@ import (
 
 func init() {
 	register("CloudFormationStackSet", ListCloudFormationStackSets,
-		mapCloudControl("AWS::CloudFormation::StackSet"))
+		mapCloudControl("AWS::CloudFormation::StackSet"),
+		withProperties("Name", "StackSetId"))
 }
 
 func ListCloudFormationStackSets(sess *session.Session) ([]Resource, error) {
@@ -55,7 +57,7 @@ type CloudFormationStackSet struct {
 	sleepDuration   time.Duration
 }
 
-func (cfs *CloudFormationStackSet) findStackInstances() (map[string][]string, error) {
+func (cfs *CloudFormationStackSet) findStackInstances(ctx context.Context) (map[string][]string, error) {
 	accounts := make(map[string][]string)
 
 	input := &cloudformation.ListStackInstancesInput{
@@ -63,7 +65,7 @@ func (cfs *CloudFormationStackSet) findStackInstances() (map[string][]string, er
 	}
 
 	for {
-		resp, err := cfs.svc.ListStackInstances(input)
+		resp, err := cfs.svc.ListStackInstancesWithContext(ctx, input)
 		if err != nil {
 			return nil, err
 		}
@@ -85,9 +87,9 @@ func (cfs *CloudFormationStackSet) findStackInstances() (map[string][]string, er
 	return accounts, nil
 }
 
-func (cfs *CloudFormationStackSet) waitForStackSetOperation(operationId string) error {
+func (cfs *CloudFormationStackSet) waitForStackSetOperation(ctx context.Context, operationId string) error {
 	for {
-		result, err := cfs.svc.DescribeStackSetOperation(&cloudformation.DescribeStackSetOperationInput{
+		result, err := cfs.svc.DescribeStackSetOperationWithContext(ctx, &cloudformation.DescribeStackSetOperationInput{
 			StackSetName: cfs.stackSetSummary.StackSetName,
 			OperationId:  &operationId,
 		})
@@ -101,19 +103,23 @@ func (cfs *CloudFormationStackSet) waitForStackSetOperation(operationId string)
 			return fmt.Errorf("unable to delete stackSet=%s operationId=%s status=%s", *cfs.stackSetSummary.StackSetName, operationId, *result.StackSetOperation.Status)
 		} else {
 			logrus.Infof("Waiting on stackSet=%s operationId=%s status=%s", *cfs.stackSetSummary.StackSetName, operationId, *result.StackSetOperation.Status)
-			time.Sleep(cfs.sleepDuration)
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(cfs.sleepDuration):
+			}
 		}
 	}
 }
 
-func (cfs *CloudFormationStackSet) deleteStackInstances(accountId string, regions []string) error {
+func (cfs *CloudFormationStackSet) deleteStackInstances(ctx context.Context, accountId string, regions []string) error {
 	logrus.Infof("Deleting stack instance accountId=%s regions=%s", accountId, strings.Join(regions, ","))
 	regionsInput := make([]*string, len(regions))
 	for i, region := range regions {
 		regionsInput[i] = aws.String(region)
 		fmt.Printf("region=%s i=%d\n", region, i)
 	}
-	result, err := cfs.svc.DeleteStackInstances(&cloudformation.DeleteStackInstancesInput{
+	result, err := cfs.svc.DeleteStackInstancesWithContext(ctx, &cloudformation.DeleteStackInstancesInput{
 		StackSetName: cfs.stackSetSummary.StackSetName,
 		Accounts:     []*string{&accountId},
 		Regions:      regionsInput,
@@ -129,21 +135,25 @@ func (cfs *CloudFormationStackSet) deleteStackInstances(accountId string, region
 		return err
 	}
 
-	return cfs.waitForStackSetOperation(*result.OperationId)
+	return cfs.waitForStackSetOperation(ctx, *result.OperationId)
 }
 
 func (cfs *CloudFormationStackSet) Remove() error {
-	accounts, err := cfs.findStackInstances()
+	return cfs.RemoveWithContext(aws.BackgroundContext())
+}
+
+func (cfs *CloudFormationStackSet) RemoveWithContext(ctx context.Context) error {
+	accounts, err := cfs.findStackInstances(ctx)
 	if err != nil {
 		return err
 	}
 	for accountId, regions := range accounts {
-		err := cfs.deleteStackInstances(accountId, regions)
+		err := cfs.deleteStackInstances(ctx, accountId, regions)
 		if err != nil {
 			return err
 		}
 	}
-	_, err = cfs.svc.DeleteStackSet(&cloudformation.DeleteStackSetInput{
+	_, err = cfs.svc.DeleteStackSetWithContext(ctx, &cloudformation.DeleteStackSetInput{
 		StackSetName: cfs.stackSetSummary.StackSetName,
 	})
 	return err
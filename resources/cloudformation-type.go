@@ -10,7 +10,8 @@ import (
 )
 
 func init() {
-	register("CloudFormationType", ListCloudFormationTypes)
+	register("CloudFormationType", ListCloudFormationTypes,
+		withProperties("Name", "Type"))
 }
 
 func ListCloudFormationTypes(sess *session.Session) ([]Resource, error) {
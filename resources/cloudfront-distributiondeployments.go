@@ -17,7 +17,8 @@ type CloudFrontDistributionDeployment struct {
 }
 
 func init() {
-	register("CloudFrontDistributionDeployment", ListCloudFrontDistributionDeployments)
+	register("CloudFrontDistributionDeployment", ListCloudFrontDistributionDeployments,
+		withFilter())
 }
 
 func ListCloudFrontDistributionDeployments(sess *session.Session) ([]Resource, error) {
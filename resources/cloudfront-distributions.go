@@ -18,7 +18,8 @@ type CloudFrontDistribution struct {
 }
 
 func init() {
-	register("CloudFrontDistribution", ListCloudFrontDistributions)
+	register("CloudFrontDistribution", ListCloudFrontDistributions,
+		withProperties("LastModifiedTime", "tag:*"))
 }
 
 func ListCloudFrontDistributions(sess *session.Session) ([]Resource, error) {
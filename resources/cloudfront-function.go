@@ -13,7 +13,8 @@ type CloudFrontFunction struct {
 }
 
 func init() {
-	register("CloudFrontFunction", ListCloudFrontFunctions)
+	register("CloudFrontFunction", ListCloudFrontFunctions,
+		withProperties("name", "stage"))
 }
 
 func ListCloudFrontFunctions(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type CloudFrontKeyGroup struct {
 }
 
 func init() {
-	register("CloudFrontKeyGroup", ListCloudFrontKeyGroups)
+	register("CloudFrontKeyGroup", ListCloudFrontKeyGroups,
+		withProperties("ID", "Name", "LastModifiedTime"))
 }
 
 func ListCloudFrontKeyGroups(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,8 @@ type CloudFrontOriginAccessControl struct {
 }
 
 func init() {
-	register("CloudFrontOriginAccessControl", ListCloudFrontOriginAccessControls)
+	register("CloudFrontOriginAccessControl", ListCloudFrontOriginAccessControls,
+		withProperties("ID"))
 }
 
 func ListCloudFrontOriginAccessControls(sess *session.Session) ([]Resource, error) {
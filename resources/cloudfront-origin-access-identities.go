@@ -12,7 +12,8 @@ type CloudFrontOriginAccessIdentity struct {
 }
 
 func init() {
-	register("CloudFrontOriginAccessIdentity", ListCloudFrontOriginAccessIdentities)
+	register("CloudFrontOriginAccessIdentity", ListCloudFrontOriginAccessIdentities,
+		withProperties("ID"))
 }
 
 func ListCloudFrontOriginAccessIdentities(sess *session.Session) ([]Resource, error) {
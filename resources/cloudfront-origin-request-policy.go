@@ -12,7 +12,8 @@ type CloudFrontOriginRequestPolicy struct {
 }
 
 func init() {
-	register("CloudFrontOriginRequestPolicy", ListCloudFrontOriginRequestPolicies)
+	register("CloudFrontOriginRequestPolicy", ListCloudFrontOriginRequestPolicies,
+		withProperties("ID"))
 }
 
 func ListCloudFrontOriginRequestPolicies(sess *session.Session) ([]Resource, error) {
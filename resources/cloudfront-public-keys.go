@@ -16,7 +16,8 @@ type CloudFrontPublicKey struct {
 }
 
 func init() {
-	register("CloudFrontPublicKey", ListCloudFrontPublicKeys)
+	register("CloudFrontPublicKey", ListCloudFrontPublicKeys,
+		withProperties("ID", "Name", "CreatedTime"))
 }
 
 func ListCloudFrontPublicKeys(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,9 @@ type CloudFrontResponseHeadersPolicy struct {
 }
 
 func init() {
-	register("CloudFrontResponseHeadersPolicy", ListCloudFrontResponseHeadersPolicies)
+	register("CloudFrontResponseHeadersPolicy", ListCloudFrontResponseHeadersPolicies,
+		withProperties("ID", "Name"),
+		withFilter())
 }
 
 func ListCloudFrontResponseHeadersPolicies(sess *session.Session) ([]Resource, error) {
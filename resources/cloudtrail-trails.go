@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("CloudTrailTrail", ListCloudTrailTrails)
+	register("CloudTrailTrail", ListCloudTrailTrails,
+		withProperties("Name"))
 }
 
 func ListCloudTrailTrails(sess *session.Session) ([]Resource, error) {
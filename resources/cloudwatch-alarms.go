@@ -14,7 +14,8 @@ type CloudWatchAlarm struct {
 }
 
 func init() {
-	register("CloudWatchAlarm", ListCloudWatchAlarms)
+	register("CloudWatchAlarm", ListCloudWatchAlarms,
+		withProperties("Name", "tag:*"))
 }
 
 func ListCloudWatchAlarms(sess *session.Session) ([]Resource, error) {
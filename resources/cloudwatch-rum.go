@@ -14,7 +14,8 @@ type CloudWatchRumApp struct {
 }
 
 func init() {
-	register("CloudWatchRUMApp", ListCloudWatchRumApp)
+	register("CloudWatchRUMApp", ListCloudWatchRumApp,
+		withProperties("Name", "ID", "State"))
 }
 
 func ListCloudWatchRumApp(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,8 @@ type CloudWatchLogsLogGroup struct {
 }
 
 func init() {
-	register("CloudWatchLogsLogGroup", ListCloudWatchLogsLogGroups)
+	register("CloudWatchLogsLogGroup", ListCloudWatchLogsLogGroups,
+		withProperties("logGroupName", "CreatedTime", "LastEvent", "tag:*"))
 }
 
 func ListCloudWatchLogsLogGroups(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type CloudWatchLogsResourcePolicy struct {
 }
 
 func init() {
-	register("CloudWatchLogsResourcePolicy", ListCloudWatchLogsResourcePolicies)
+	register("CloudWatchLogsResourcePolicy", ListCloudWatchLogsResourcePolicies,
+		withProperties("Name"))
 }
 
 func ListCloudWatchLogsResourcePolicies(sess *session.Session) ([]Resource, error) {
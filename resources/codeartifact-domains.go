@@ -13,7 +13,8 @@ type CodeArtifactDomain struct {
 }
 
 func init() {
-	register("CodeArtifactDomain", ListCodeArtifactDomains)
+	register("CodeArtifactDomain", ListCodeArtifactDomains,
+		withProperties("Name", "tag:*"))
 }
 
 func ListCodeArtifactDomains(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type CodeArtifactRepository struct {
 }
 
 func init() {
-	register("CodeArtifactRepository", ListCodeArtifactRepositories)
+	register("CodeArtifactRepository", ListCodeArtifactRepositories,
+		withProperties("Name", "Domain", "tag:*"))
 }
 
 func ListCodeArtifactRepositories(sess *session.Session) ([]Resource, error) {
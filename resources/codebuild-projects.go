@@ -13,7 +13,8 @@ type CodeBuildProject struct {
 }
 
 func init() {
-	register("CodeBuildProject", ListCodeBuildProjects)
+	register("CodeBuildProject", ListCodeBuildProjects,
+		withProperties("ProjectName", "tag:*"))
 }
 
 func GetTags(svc *codebuild.CodeBuild, project *string) map[string]*string {
@@ -15,7 +15,8 @@ type CodeStarConnection struct {
 }
 
 func init() {
-	register("CodeStarConnection", ListCodeStarConnections)
+	register("CodeStarConnection", ListCodeStarConnections,
+		withProperties("Name", "ProviderType"))
 }
 
 func ListCodeStarConnections(sess *session.Session) ([]Resource, error) {
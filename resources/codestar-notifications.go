@@ -18,7 +18,8 @@ type CodeStarNotificationRule struct {
 }
 
 func init() {
-	register("CodeStarNotificationRule", ListCodeStarNotificationRules)
+	register("CodeStarNotificationRule", ListCodeStarNotificationRules,
+		withProperties("Name", "ID", "tag:*"))
 }
 
 func ListCodeStarNotificationRules(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type CognitoIdentityProvider struct {
 }
 
 func init() {
-	register("CognitoIdentityProvider", ListCognitoIdentityProviders)
+	register("CognitoIdentityProvider", ListCognitoIdentityProviders,
+		withProperties("Type", "UserPoolName", "Name"))
 }
 
 func ListCognitoIdentityProviders(sess *session.Session) ([]Resource, error) {
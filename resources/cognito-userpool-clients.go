@@ -18,7 +18,8 @@ type CognitoUserPoolClient struct {
 }
 
 func init() {
-	register("CognitoUserPoolClient", ListCognitoUserPoolClients)
+	register("CognitoUserPoolClient", ListCognitoUserPoolClients,
+		withProperties("ID", "Name", "UserPoolName", "tag:*"))
 }
 
 func ListCognitoUserPoolClients(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type CognitoUserPoolDomain struct {
 }
 
 func init() {
-	register("CognitoUserPoolDomain", ListCognitoUserPoolDomains)
+	register("CognitoUserPoolDomain", ListCognitoUserPoolDomains,
+		withProperties("Name", "UserPoolName", "tag:*"))
 }
 
 func ListCognitoUserPoolDomains(sess *session.Session) ([]Resource, error) {
@@ -22,7 +22,9 @@ type CognitoUserPool struct {
 }
 
 func init() {
-	register("CognitoUserPool", ListCognitoUserPools)
+	register("CognitoUserPool", ListCognitoUserPools,
+		withProperties("Name", "Identifier", "tag:*"),
+		withFeatureFlags("disable-deletion-protection.CognitoUserPool"))
 }
 
 func ListCognitoUserPools(sess *session.Session) ([]Resource, error) {
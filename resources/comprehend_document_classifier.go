@@ -8,7 +8,8 @@ import (
 )
 
 func init() {
-	register("ComprehendDocumentClassifier", ListComprehendDocumentClassifiers)
+	register("ComprehendDocumentClassifier", ListComprehendDocumentClassifiers,
+		withProperties("LanguageCode", "DocumentClassifierArn"))
 }
 
 func ListComprehendDocumentClassifiers(sess *session.Session) ([]Resource, error) {
@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendDominantLanguageDetectionJob", ListComprehendDominantLanguageDetectionJobs)
+	register("ComprehendDominantLanguageDetectionJob", ListComprehendDominantLanguageDetectionJobs,
+		withProperties("JobName", "JobId"))
 }
 
 func ListComprehendDominantLanguageDetectionJobs(sess *session.Session) ([]Resource, error) {
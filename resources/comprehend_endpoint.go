@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendEndpoint", ListComprehendEndpoints)
+	register("ComprehendEndpoint", ListComprehendEndpoints,
+		withProperties("EndpointArn", "ModelArn"))
 }
 
 func ListComprehendEndpoints(sess *session.Session) ([]Resource, error) {
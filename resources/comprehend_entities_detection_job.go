@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendEntitiesDetectionJob", ListComprehendEntitiesDetectionJobs)
+	register("ComprehendEntitiesDetectionJob", ListComprehendEntitiesDetectionJobs,
+		withProperties("JobName", "JobId"))
 }
 
 func ListComprehendEntitiesDetectionJobs(sess *session.Session) ([]Resource, error) {
@@ -8,7 +8,8 @@ import (
 )
 
 func init() {
-	register("ComprehendEntityRecognizer", ListComprehendEntityRecognizers)
+	register("ComprehendEntityRecognizer", ListComprehendEntityRecognizers,
+		withProperties("LanguageCode", "EntityRecognizerArn"))
 }
 
 func ListComprehendEntityRecognizers(sess *session.Session) ([]Resource, error) {
@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendEventsDetectionJob", ListComprehendEventsDetectionJobs)
+	register("ComprehendEventsDetectionJob", ListComprehendEventsDetectionJobs,
+		withProperties("JobName", "JobId"))
 }
 
 func ListComprehendEventsDetectionJobs(sess *session.Session) ([]Resource, error) {
@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendKeyPhrasesDetectionJob", ListComprehendKeyPhrasesDetectionJobs)
+	register("ComprehendKeyPhrasesDetectionJob", ListComprehendKeyPhrasesDetectionJobs,
+		withProperties("JobName", "JobId"))
 }
 
 func ListComprehendKeyPhrasesDetectionJobs(sess *session.Session) ([]Resource, error) {
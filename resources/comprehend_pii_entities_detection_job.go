@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendPiiEntititesDetectionJob", ListComprehendPiiEntitiesDetectionJobs)
+	register("ComprehendPiiEntititesDetectionJob", ListComprehendPiiEntitiesDetectionJobs,
+		withProperties("JobName", "JobId"))
 }
 
 func ListComprehendPiiEntitiesDetectionJobs(sess *session.Session) ([]Resource, error) {
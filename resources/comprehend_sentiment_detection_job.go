@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendSentimentDetectionJob", ListComprehendSentimentDetectionJobs)
+	register("ComprehendSentimentDetectionJob", ListComprehendSentimentDetectionJobs,
+		withProperties("JobName", "JobId"))
 }
 
 func ListComprehendSentimentDetectionJobs(sess *session.Session) ([]Resource, error) {
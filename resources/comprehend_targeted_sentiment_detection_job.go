@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("ComprehendTargetedSentimentDetectionJob", ListComprehendTargetedSentimentDetectionJobs)
+	register("ComprehendTargetedSentimentDetectionJob", ListComprehendTargetedSentimentDetectionJobs,
+		withProperties("JobName", "JobId"))
 }
 
 func ListComprehendTargetedSentimentDetectionJobs(sess *session.Session) ([]Resource, error) {
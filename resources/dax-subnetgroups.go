@@ -13,7 +13,8 @@ type DAXSubnetGroup struct {
 }
 
 func init() {
-	register("DAXSubnetGroup", ListDAXSubnetGroups)
+	register("DAXSubnetGroup", ListDAXSubnetGroups,
+		withFilter())
 }
 
 func ListDAXSubnetGroups(sess *session.Session) ([]Resource, error) {
@@ -19,7 +19,8 @@ type DynamoDBTableItem struct {
 }
 
 func init() {
-	register("DynamoDBTableItem", ListDynamoDBItems)
+	register("DynamoDBTableItem", ListDynamoDBItems,
+		withProperties("Table", "KeyName", "KeyValue"))
 }
 
 func ListDynamoDBItems(sess *session.Session) ([]Resource, error) {
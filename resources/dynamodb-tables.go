@@ -1,7 +1,10 @@
 package resources
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -23,7 +26,9 @@ type DynamoDBTable struct {
 }
 
 func init() {
-	register("DynamoDBTable", ListDynamoDBTables)
+	register("DynamoDBTable", ListDynamoDBTables,
+		withProperties("Identifier", "tag:*"),
+		withFeatureFlags("disable-deletion-protection.DynamoDBTable"))
 }
 
 func ListDynamoDBTables(sess *session.Session) ([]Resource, error) {
@@ -104,6 +109,45 @@ func (i *DynamoDBTable) doRemove() error {
 	return nil
 }
 
+// Snapshot creates an on-demand backup of the table. DynamoDB backups cannot
+// be tagged, so the run is only recognizable by the backup name. This does
+// not break the retention, since no resource type removes DynamoDB backups.
+func (i *DynamoDBTable) Snapshot(ctx context.Context, opts SnapshotOptions) (string, error) {
+	resp, err := i.svc.CreateBackupWithContext(ctx, &dynamodb.CreateBackupInput{
+		TableName:  aws.String(i.id),
+		BackupName: aws.String(snapshotName(opts, i.id)),
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(resp.BackupDetails.BackupArn), nil
+}
+
+func (i *DynamoDBTable) WaitForSnapshot(ctx context.Context, arn string, opts SnapshotOptions) error {
+	for {
+		resp, err := i.svc.DescribeBackupWithContext(ctx, &dynamodb.DescribeBackupInput{
+			BackupArn: aws.String(arn),
+		})
+		if err != nil {
+			return err
+		}
+
+		switch status := aws.StringValue(resp.BackupDescription.BackupDetails.BackupStatus); status {
+		case dynamodb.BackupStatusAvailable:
+			return nil
+		case dynamodb.BackupStatusDeleted:
+			return fmt.Errorf("backup %s is %s", arn, status)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(5 * time.Second):
+		}
+	}
+}
+
 func GetTableTags(svc *dynamodb.DynamoDB, tableName *string) ([]*dynamodb.Tag, error) {
 	result, err := svc.DescribeTable(&dynamodb.DescribeTableInput{
 		TableName: aws.String(*tableName),
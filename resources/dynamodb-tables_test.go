@@ -0,0 +1,36 @@
+package resources
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDynamoDBTablesListAndRemove(t *testing.T) {
+	server := awstest.NewServer(t)
+	server.Handle("ListTables",
+		awstest.JSON(`{"TableNames": ["orders", "broken"]}`))
+	server.Handle("DescribeTable",
+		awstest.JSON(`{"Table": {"TableName": "orders", "TableArn": "arn:aws:dynamodb:eu-west-1:123456789012:table/orders"}}`),
+		awstest.Error(http.StatusBadRequest, "ResourceNotFoundException", "table not found"))
+	server.Handle("ListTagsOfResource",
+		awstest.JSON(`{"Tags": [{"Key": "env", "Value": "test"}]}`))
+	server.Handle("DeleteTable",
+		awstest.JSON(`{"TableDescription": {"TableName": "orders"}}`))
+
+	resources, err := ListDynamoDBTables(server.Session("dynamodb"))
+	require.NoError(t, err)
+
+	// Tables which cannot be described are skipped.
+	require.Len(t, resources, 1)
+
+	table := resources[0].(*DynamoDBTable)
+	require.Equal(t, "orders", table.String())
+	require.Equal(t, "test", table.Properties().Get("tag:env"))
+
+	require.NoError(t, table.Remove())
+	require.Len(t, server.Requests("DeleteTable"), 1)
+	require.JSONEq(t, `{"TableName": "orders"}`, string(server.Requests("DeleteTable")[0].Body))
+}
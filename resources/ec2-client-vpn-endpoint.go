@@ -13,7 +13,8 @@ type EC2ClientVpnEndpoint struct {
 }
 
 func init() {
-	register("EC2ClientVpnEndpoint", ListEC2ClientVpnEndoint)
+	register("EC2ClientVpnEndpoint", ListEC2ClientVpnEndoint,
+		withProperties("tag:cve:*"))
 }
 
 func ListEC2ClientVpnEndoint(sess *session.Session) ([]Resource, error) {
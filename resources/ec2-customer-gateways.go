@@ -14,7 +14,8 @@ type EC2CustomerGateway struct {
 }
 
 func init() {
-	register("EC2CustomerGateway", ListEC2CustomerGateways)
+	register("EC2CustomerGateway", ListEC2CustomerGateways,
+		withFilter())
 }
 
 func ListEC2CustomerGateways(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type EC2DefaultSecurityGroupRule struct {
 }
 
 func init() {
-	register("EC2DefaultSecurityGroupRule", ListEC2SecurityGroupRules)
+	register("EC2DefaultSecurityGroupRule", ListEC2SecurityGroupRules,
+		withProperties("SecurityGroupId", "tag:*"))
 }
 
 func ListEC2SecurityGroupRules(sess *session.Session) ([]Resource, error) {
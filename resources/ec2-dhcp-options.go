@@ -15,7 +15,8 @@ type EC2DHCPOption struct {
 }
 
 func init() {
-	register("EC2DHCPOption", ListEC2DHCPOptions)
+	register("EC2DHCPOption", ListEC2DHCPOptions,
+		withProperties("DefaultVPC", "OwnerID", "tag:*"))
 }
 
 func ListEC2DHCPOptions(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type EC2EgressOnlyInternetGateway struct {
 }
 
 func init() {
-	register("EC2EgressOnlyInternetGateway", ListEC2EgressOnlyInternetGateways)
+	register("EC2EgressOnlyInternetGateway", ListEC2EgressOnlyInternetGateways,
+		withProperties("tag:*"))
 }
 
 func ListEC2EgressOnlyInternetGateways(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type EC2Address struct {
 }
 
 func init() {
-	register("EC2Address", ListEC2Addresses)
+	register("EC2Address", ListEC2Addresses,
+		withProperties("AllocationID", "tag:*"))
 }
 
 func ListEC2Addresses(sess *session.Session) ([]Resource, error) {
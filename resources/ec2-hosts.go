@@ -18,7 +18,9 @@ type EC2Host struct {
 }
 
 func init() {
-	register("EC2Host", ListEC2Hosts)
+	register("EC2Host", ListEC2Hosts,
+		withProperties("Identifier", "HostInstanceFamily", "HostCores", "HostState", "AllocationTime", "tag:*"),
+		withFilter())
 }
 
 func ListEC2Hosts(sess *session.Session) ([]Resource, error) {
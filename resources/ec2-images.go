@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
@@ -16,7 +18,8 @@ type EC2Image struct {
 }
 
 func init() {
-	register("EC2Image", ListEC2Images)
+	register("EC2Image", ListEC2Images,
+		withProperties("CreationDate", "Name", "tag:*"))
 }
 
 func ListEC2Images(sess *session.Session) ([]Resource, error) {
@@ -64,6 +67,14 @@ func (e *EC2Image) Properties() types.Properties {
 	return properties
 }
 
+func (e *EC2Image) CreatedAt() *time.Time {
+	createdAt, err := time.Parse(time.RFC3339, e.creationDate)
+	if err != nil {
+		return nil
+	}
+	return &createdAt
+}
+
 func (e *EC2Image) String() string {
 	return e.id
 }
@@ -23,7 +23,8 @@ type EC2InstanceConnectEndpoint struct {
 }
 
 func init() {
-	register("EC2InstanceConnectEndpoint", ListEC2InstanceConnectEndpoints)
+	register("EC2InstanceConnectEndpoint", ListEC2InstanceConnectEndpoints,
+		withProperties("ID", "AZ", "CreatedAt", "DNSName", "FIPSDNSName", "OwnerID", "State", "SubnetID", "VPCID", "tag:*"))
 }
 
 func ListEC2InstanceConnectEndpoints(sess *session.Session) ([]Resource, error) {
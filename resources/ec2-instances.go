@@ -20,7 +20,10 @@ type EC2Instance struct {
 }
 
 func init() {
-	register("EC2Instance", ListEC2Instances)
+	register("EC2Instance", ListEC2Instances,
+		withProperties("Identifier", "ImageIdentifier", "InstanceState", "InstanceType", "LaunchTime", "tag:*"),
+		withFilter(),
+		withFeatureFlags("disable-deletion-protection.EC2Instance", "disable-ec2-instance-stop-protection"))
 }
 
 func ListEC2Instances(sess *session.Session) ([]Resource, error) {
@@ -153,6 +156,10 @@ func (i *EC2Instance) Properties() types.Properties {
 	return properties
 }
 
+func (i *EC2Instance) CreatedAt() *time.Time {
+	return i.instance.LaunchTime
+}
+
 func (i *EC2Instance) String() string {
 	return *i.instance.InstanceId
 }
@@ -21,7 +21,8 @@ type EC2InternetGatewayAttachment struct {
 }
 
 func init() {
-	register("EC2InternetGatewayAttachment", ListEC2InternetGatewayAttachments)
+	register("EC2InternetGatewayAttachment", ListEC2InternetGatewayAttachments,
+		withProperties("DefaultVPC", "vpc:*", "igw:*", "tag:igw:*", "tag:vpc:*"))
 }
 
 func ListEC2InternetGatewayAttachments(sess *session.Session) ([]Resource, error) {
@@ -96,3 +97,11 @@ func (e *EC2InternetGatewayAttachment) Properties() types.Properties {
 func (e *EC2InternetGatewayAttachment) String() string {
 	return fmt.Sprintf("%s -> %s", *e.igwId, *e.vpcId)
 }
+
+func (e *EC2InternetGatewayAttachment) DependsOn() []string {
+	return []string{
+		"EC2Instance",
+		"EC2NATGateway",
+		"EC2Address",
+	}
+}
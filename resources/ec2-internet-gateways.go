@@ -13,7 +13,8 @@ type EC2InternetGateway struct {
 }
 
 func init() {
-	register("EC2InternetGateway", ListEC2InternetGateways)
+	register("EC2InternetGateway", ListEC2InternetGateways,
+		withProperties("DefaultVPC", "OwnerID", "tag:*"))
 }
 
 func ListEC2InternetGateways(sess *session.Session) ([]Resource, error) {
@@ -80,3 +81,9 @@ func (e *EC2InternetGateway) Properties() types.Properties {
 func (e *EC2InternetGateway) String() string {
 	return *e.igw.InternetGatewayId
 }
+
+func (e *EC2InternetGateway) DependsOn() []string {
+	return []string{
+		"EC2InternetGatewayAttachment",
+	}
+}
@@ -13,7 +13,8 @@ type EC2KeyPair struct {
 }
 
 func init() {
-	register("EC2KeyPair", ListEC2KeyPairs)
+	register("EC2KeyPair", ListEC2KeyPairs,
+		withProperties("Name", "tag:*"))
 }
 
 func ListEC2KeyPairs(sess *session.Session) ([]Resource, error) {
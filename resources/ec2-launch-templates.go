@@ -13,7 +13,8 @@ type EC2LaunchTemplate struct {
 }
 
 func init() {
-	register("EC2LaunchTemplate", ListEC2LaunchTemplates)
+	register("EC2LaunchTemplate", ListEC2LaunchTemplates,
+		withProperties("Name", "tag:*"))
 }
 
 func ListEC2LaunchTemplates(sess *session.Session) ([]Resource, error) {
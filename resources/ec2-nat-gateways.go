@@ -14,7 +14,9 @@ type EC2NATGateway struct {
 }
 
 func init() {
-	register("EC2NATGateway", ListEC2NATGateways)
+	register("EC2NATGateway", ListEC2NATGateways,
+		withProperties("tag:*"),
+		withFilter())
 }
 
 func ListEC2NATGateways(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,9 @@ type EC2NetworkACL struct {
 }
 
 func init() {
-	register("EC2NetworkACL", ListEC2NetworkACLs)
+	register("EC2NetworkACL", ListEC2NetworkACLs,
+		withProperties("ID", "OwnerID", "tag:*"),
+		withFilter())
 }
 
 func ListEC2NetworkACLs(sess *session.Session) ([]Resource, error) {
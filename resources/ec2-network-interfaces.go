@@ -16,7 +16,8 @@ type EC2NetworkInterface struct {
 }
 
 func init() {
-	register("EC2NetworkInterface", ListEC2NetworkInterfaces)
+	register("EC2NetworkInterface", ListEC2NetworkInterfaces,
+		withProperties("ID", "VPC", "AvailabilityZone", "PrivateIPAddress", "SubnetID", "Status", "tag:*"))
 }
 
 func ListEC2NetworkInterfaces(sess *session.Session) ([]Resource, error) {
@@ -87,3 +88,11 @@ func (r *EC2NetworkInterface) Properties() types.Properties {
 func (r *EC2NetworkInterface) String() string {
 	return *r.eni.NetworkInterfaceId
 }
+
+func (r *EC2NetworkInterface) DependsOn() []string {
+	return []string{
+		"EC2Instance",
+		"EC2NATGateway",
+		"EC2VPCEndpoint",
+	}
+}
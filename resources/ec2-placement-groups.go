@@ -14,7 +14,8 @@ type EC2PlacementGroup struct {
 }
 
 func init() {
-	register("EC2PlacementGroup", ListEC2PlacementGroups)
+	register("EC2PlacementGroup", ListEC2PlacementGroups,
+		withFilter())
 }
 
 func ListEC2PlacementGroups(sess *session.Session) ([]Resource, error) {
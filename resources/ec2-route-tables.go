@@ -16,7 +16,9 @@ type EC2RouteTable struct {
 }
 
 func init() {
-	register("EC2RouteTable", ListEC2RouteTables)
+	register("EC2RouteTable", ListEC2RouteTables,
+		withProperties("DefaultVPC", "OwnerID", "tag:*"),
+		withFilter())
 }
 
 func ListEC2RouteTables(sess *session.Session) ([]Resource, error) {
@@ -19,7 +19,9 @@ type EC2SecurityGroup struct {
 }
 
 func init() {
-	register("EC2SecurityGroup", ListEC2SecurityGroups)
+	register("EC2SecurityGroup", ListEC2SecurityGroups,
+		withProperties("Name", "OwnerID", "tag:*"),
+		withFilter())
 }
 
 func ListEC2SecurityGroups(sess *session.Session) ([]Resource, error) {
@@ -102,3 +104,10 @@ func (sg *EC2SecurityGroup) Properties() types.Properties {
 func (sg *EC2SecurityGroup) String() string {
 	return *sg.id
 }
+
+func (sg *EC2SecurityGroup) DependsOn() []string {
+	return []string{
+		"EC2Instance",
+		"EC2NetworkInterface",
+	}
+}
@@ -18,7 +18,8 @@ type EC2Snapshot struct {
 }
 
 func init() {
-	register("EC2Snapshot", ListEC2Snapshots)
+	register("EC2Snapshot", ListEC2Snapshots,
+		withProperties("StartTime", "tag:*"))
 }
 
 func ListEC2Snapshots(sess *session.Session) ([]Resource, error) {
@@ -63,6 +64,10 @@ func (e *EC2Snapshot) Remove() error {
 	return err
 }
 
+func (e *EC2Snapshot) CreatedAt() *time.Time {
+	return e.startTime
+}
+
 func (e *EC2Snapshot) String() string {
 	return e.id
 }
@@ -15,7 +15,8 @@ type EC2SpotFleetRequest struct {
 }
 
 func init() {
-	register("EC2SpotFleetRequest", ListEC2SpotFleetRequests)
+	register("EC2SpotFleetRequest", ListEC2SpotFleetRequests,
+		withFilter())
 }
 
 func ListEC2SpotFleetRequests(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type EC2Subnet struct {
 }
 
 func init() {
-	register("EC2Subnet", ListEC2Subnets)
+	register("EC2Subnet", ListEC2Subnets,
+		withProperties("DefaultForAz", "DefaultVPC", "OwnerID", "tag:*"))
 }
 
 func ListEC2Subnets(sess *session.Session) ([]Resource, error) {
@@ -69,3 +70,11 @@ func (e *EC2Subnet) Properties() types.Properties {
 func (e *EC2Subnet) String() string {
 	return *e.subnet.SubnetId
 }
+
+func (e *EC2Subnet) DependsOn() []string {
+	return []string{
+		"EC2Instance",
+		"EC2NetworkInterface",
+		"EC2NATGateway",
+	}
+}
@@ -14,7 +14,9 @@ type EC2TGWAttachment struct {
 }
 
 func init() {
-	register("EC2TGWAttachment", ListEC2TGWAttachments)
+	register("EC2TGWAttachment", ListEC2TGWAttachments,
+		withProperties("ID", "tag:*"),
+		withFilter())
 }
 
 func ListEC2TGWAttachments(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,9 @@ type EC2TGW struct {
 }
 
 func init() {
-	register("EC2TGW", ListEC2TGWs)
+	register("EC2TGW", ListEC2TGWs,
+		withProperties("ID", "OwnerId", "tag:*"),
+		withFilter())
 }
 
 func ListEC2TGWs(sess *session.Session) ([]Resource, error) {
@@ -1,6 +1,12 @@
 package resources
 
 import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
@@ -12,7 +18,8 @@ type EC2Volume struct {
 }
 
 func init() {
-	register("EC2Volume", ListEC2Volumes)
+	register("EC2Volume", ListEC2Volumes,
+		withProperties("State", "tag:*"))
 }
 
 func ListEC2Volumes(sess *session.Session) ([]Resource, error) {
@@ -41,6 +48,38 @@ func (e *EC2Volume) Remove() error {
 	return err
 }
 
+func (e *EC2Volume) Snapshot(ctx context.Context, opts SnapshotOptions) (string, error) {
+	tags := []*ec2.Tag{
+		{Key: aws.String("Name"), Value: aws.String(snapshotName(opts, *e.volume.VolumeId))},
+	}
+	for _, key := range sortedTagKeys(opts.Tags) {
+		tags = append(tags, &ec2.Tag{Key: aws.String(key), Value: aws.String(opts.Tags[key])})
+	}
+
+	snapshot, err := e.svc.CreateSnapshotWithContext(ctx, &ec2.CreateSnapshotInput{
+		VolumeId:    e.volume.VolumeId,
+		Description: aws.String(fmt.Sprintf("Final snapshot of %s", *e.volume.VolumeId)),
+		TagSpecifications: []*ec2.TagSpecification{{
+			ResourceType: aws.String(ec2.ResourceTypeSnapshot),
+			Tags:         tags,
+		}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	region := aws.StringValue(e.svc.Config.Region)
+	partition, _ := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	return fmt.Sprintf("arn:%s:ec2:%s::snapshot/%s",
+		partition.ID(), region, aws.StringValue(snapshot.SnapshotId)), nil
+}
+
+func (e *EC2Volume) WaitForSnapshot(ctx context.Context, arn string, opts SnapshotOptions) error {
+	return e.svc.WaitUntilSnapshotCompletedWithContext(ctx, &ec2.DescribeSnapshotsInput{
+		SnapshotIds: []*string{aws.String(arnResourceName(arn))},
+	})
+}
+
 func (e *EC2Volume) Properties() types.Properties {
 	properties := types.NewProperties()
 	properties.Set("State", e.volume.State)
@@ -50,6 +89,10 @@ func (e *EC2Volume) Properties() types.Properties {
 	return properties
 }
 
+func (e *EC2Volume) CreatedAt() *time.Time {
+	return e.volume.CreateTime
+}
+
 func (e *EC2Volume) String() string {
 	return *e.volume.VolumeId
 }
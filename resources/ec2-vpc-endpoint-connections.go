@@ -18,7 +18,9 @@ type EC2VPCEndpointConnection struct {
 }
 
 func init() {
-	register("EC2VPCEndpointConnection", ListEC2VPCEndpointConnections)
+	register("EC2VPCEndpointConnection", ListEC2VPCEndpointConnections,
+		withProperties("VpcEndpointID", "State", "Owner"),
+		withFilter())
 }
 
 func ListEC2VPCEndpointConnections(sess *session.Session) ([]Resource, error) {
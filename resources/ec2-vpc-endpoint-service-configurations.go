@@ -15,7 +15,8 @@ type EC2VPCEndpointServiceConfiguration struct {
 }
 
 func init() {
-	register("EC2VPCEndpointServiceConfiguration", ListEC2VPCEndpointServiceConfigurations)
+	register("EC2VPCEndpointServiceConfiguration", ListEC2VPCEndpointServiceConfigurations,
+		withProperties("Name", "Id", "tag:*"))
 }
 
 func ListEC2VPCEndpointServiceConfigurations(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type EC2VPCPeeringConnection struct {
 }
 
 func init() {
-	register("EC2VPCPeeringConnection", ListEC2VPCPeeringConnections)
+	register("EC2VPCPeeringConnection", ListEC2VPCPeeringConnections,
+		withFilter())
 }
 
 func ListEC2VPCPeeringConnections(sess *session.Session) ([]Resource, error) {
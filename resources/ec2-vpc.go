@@ -14,7 +14,8 @@ type EC2VPC struct {
 
 func init() {
 	register("EC2VPC", ListEC2VPCs,
-		mapCloudControl("AWS::EC2::VPC"))
+		mapCloudControl("AWS::EC2::VPC"),
+		withProperties("ID", "IsDefault", "OwnerID", "tag:*"))
 }
 
 func ListEC2VPCs(sess *session.Session) ([]Resource, error) {
@@ -83,3 +84,17 @@ func (e *EC2VPC) Properties() types.Properties {
 func (e *EC2VPC) String() string {
 	return *e.vpc.VpcId
 }
+
+func (e *EC2VPC) DependsOn() []string {
+	return []string{
+		"EC2Subnet",
+		"EC2SecurityGroup",
+		"EC2RouteTable",
+		"EC2NetworkACL",
+		"EC2NetworkInterface",
+		"EC2InternetGatewayAttachment",
+		"EC2EgressOnlyInternetGateway",
+		"EC2VPCEndpoint",
+		"EC2VPNGatewayAttachment",
+	}
+}
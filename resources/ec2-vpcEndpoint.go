@@ -21,7 +21,8 @@ type EC2VPCEndpoint struct {
 }
 
 func init() {
-	register("EC2VPCEndpoint", ListEC2VPCEndpoints)
+	register("EC2VPCEndpoint", ListEC2VPCEndpoints,
+		withProperties("ID", "VpcId", "State", "OwnerId", "ServiceName", "CreationTimestamp", "tag:*"))
 }
 
 func ListEC2VPCEndpoints(sess *session.Session) ([]Resource, error) {
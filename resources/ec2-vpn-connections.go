@@ -14,7 +14,9 @@ type EC2VPNConnection struct {
 }
 
 func init() {
-	register("EC2VPNConnection", ListEC2VPNConnections)
+	register("EC2VPNConnection", ListEC2VPNConnections,
+		withProperties("tag:*"),
+		withFilter())
 }
 
 func ListEC2VPNConnections(sess *session.Session) ([]Resource, error) {
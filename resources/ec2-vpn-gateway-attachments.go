@@ -19,7 +19,9 @@ type EC2VPNGatewayAttachment struct {
 }
 
 func init() {
-	register("EC2VPNGatewayAttachment", ListEC2VPNGatewayAttachments)
+	register("EC2VPNGatewayAttachment", ListEC2VPNGatewayAttachments,
+		withProperties("VpcId", "VpnId", "tag:vgw:*", "tag:vpc:*"),
+		withFilter())
 }
 
 func ListEC2VPNGatewayAttachments(sess *session.Session) ([]Resource, error) {
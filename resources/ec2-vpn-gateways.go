@@ -17,7 +17,9 @@ type EC2VPNGateway struct {
 }
 
 func init() {
-	register("EC2VPNGateway", ListEC2VPNGateways)
+	register("EC2VPNGateway", ListEC2VPNGateways,
+		withProperties("ID", "State", "tag:*"),
+		withFilter())
 }
 
 func ListEC2VPNGateways(sess *session.Session) ([]Resource, error) {
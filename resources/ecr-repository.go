@@ -19,7 +19,9 @@ type ECRRepository struct {
 
 func init() {
 	register("ECRRepository", ListECRRepositories,
-		mapCloudControl("AWS::ECR::Repository"))
+		mapCloudControl("AWS::ECR::Repository"),
+		withProperties("CreatedTime", "tag:*"),
+		withFilter())
 }
 
 func ListECRRepositories(sess *session.Session) ([]Resource, error) {
@@ -84,6 +86,10 @@ func (r *ECRRepository) Remove() error {
 	return err
 }
 
+func (r *ECRRepository) CreatedAt() *time.Time {
+	return r.createdTime
+}
+
 func (r *ECRRepository) String() string {
 	return fmt.Sprintf("Repository: %s", *r.name)
 }
@@ -14,7 +14,9 @@ type ECSTask struct {
 }
 
 func init() {
-	register("ECSTask", ListECSTasks)
+	register("ECSTask", ListECSTasks,
+		withProperties("TaskARN", "ClusterARN"),
+		withFilter())
 }
 
 func ListECSTasks(sess *session.Session) ([]Resource, error) {
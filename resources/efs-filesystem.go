@@ -1,20 +1,26 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/backup"
 	"github.com/aws/aws-sdk-go/service/efs"
 	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
 )
 
 type EFSFileSystem struct {
-	svc     *efs.EFS
-	id      string
-	name    string
-	tagList []*efs.Tag
+	svc       *efs.EFS
+	backupSvc *backup.Backup
+	arn       string
+	id        string
+	name      string
+	tagList   []*efs.Tag
 }
 
 func init() {
-	register("EFSFileSystem", ListEFSFileSystems)
+	register("EFSFileSystem", ListEFSFileSystems,
+		withProperties("tag:*"))
 }
 
 func ListEFSFileSystems(sess *session.Session) ([]Resource, error) {
@@ -32,10 +38,12 @@ func ListEFSFileSystems(sess *session.Session) ([]Resource, error) {
 			return nil, err
 		}
 		resources = append(resources, &EFSFileSystem{
-			svc:     svc,
-			id:      *fs.FileSystemId,
-			name:    *fs.CreationToken,
-			tagList: lto.Tags,
+			svc:       svc,
+			backupSvc: backup.New(sess),
+			arn:       *fs.FileSystemArn,
+			id:        *fs.FileSystemId,
+			name:      *fs.CreationToken,
+			tagList:   lto.Tags,
 		})
 
 	}
@@ -51,6 +59,16 @@ func (e *EFSFileSystem) Remove() error {
 	return err
 }
 
+// Snapshot backs up the file system with AWS Backup, since EFS has no native
+// snapshots.
+func (e *EFSFileSystem) Snapshot(ctx context.Context, opts SnapshotOptions) (string, error) {
+	return backupResource(ctx, e.backupSvc, e.arn, opts)
+}
+
+func (e *EFSFileSystem) WaitForSnapshot(ctx context.Context, arn string, opts SnapshotOptions) error {
+	return waitForBackup(ctx, e.backupSvc, e.arn, arn, opts)
+}
+
 func (e *EFSFileSystem) Properties() types.Properties {
 	properties := types.NewProperties()
 	for _, t := range e.tagList {
@@ -16,7 +16,8 @@ type EFSMountTarget struct {
 }
 
 func init() {
-	register("EFSMountTarget", ListEFSMountTargets)
+	register("EFSMountTarget", ListEFSMountTargets,
+		withProperties("Name", "ID", "tag:efs:*"))
 }
 
 func ListEFSMountTargets(sess *session.Session) ([]Resource, error) {
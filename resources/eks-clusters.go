@@ -16,7 +16,8 @@ type EKSCluster struct {
 }
 
 func init() {
-	register("EKSCluster", ListEKSClusters)
+	register("EKSCluster", ListEKSClusters,
+		withProperties("CreatedAt", "tag:*"))
 }
 
 func ListEKSClusters(sess *session.Session) ([]Resource, error) {
@@ -71,6 +72,10 @@ func (f *EKSCluster) Properties() types.Properties {
 	return properties
 }
 
+func (f *EKSCluster) CreatedAt() *time.Time {
+	return f.cluster.CreatedAt
+}
+
 func (f *EKSCluster) String() string {
 	return *f.name
 }
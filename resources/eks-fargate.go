@@ -16,7 +16,8 @@ type EKSFargateProfile struct {
 }
 
 func init() {
-	register("EKSFargateProfiles", ListEKSFargateProfiles)
+	register("EKSFargateProfiles", ListEKSFargateProfiles,
+		withProperties("Cluster", "Profile"))
 }
 
 func ListEKSFargateProfiles(sess *session.Session) ([]Resource, error) {
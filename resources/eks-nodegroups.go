@@ -16,7 +16,8 @@ type EKSNodegroup struct {
 }
 
 func init() {
-	register("EKSNodegroups", ListEKSNodegroups)
+	register("EKSNodegroups", ListEKSNodegroups,
+		withProperties("Cluster", "Profile", "CreatedAt", "tag:*"))
 }
 
 func ListEKSNodegroups(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,9 @@ type ElasticacheCacheParameterGroup struct {
 }
 
 func init() {
-	register("ElasticacheCacheParameterGroup", ListElasticacheCacheParameterGroups)
+	register("ElasticacheCacheParameterGroup", ListElasticacheCacheParameterGroups,
+		withFilter(),
+		withProperties("GroupName", "GroupFamily"))
 }
 
 func ListElasticacheCacheParameterGroups(sess *session.Session) ([]Resource, error) {
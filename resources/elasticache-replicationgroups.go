@@ -16,7 +16,8 @@ type ElasticacheReplicationGroup struct {
 }
 
 func init() {
-	register("ElasticacheReplicationGroup", ListElasticacheReplicationGroups)
+	register("ElasticacheReplicationGroup", ListElasticacheReplicationGroups,
+		withProperties("ID", "CreateTime"))
 }
 
 func ListElasticacheReplicationGroups(sess *session.Session) ([]Resource, error) {
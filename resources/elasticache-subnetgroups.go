@@ -15,7 +15,8 @@ type ElasticacheSubnetGroup struct {
 }
 
 func init() {
-	register("ElasticacheSubnetGroup", ListElasticacheSubnetGroups)
+	register("ElasticacheSubnetGroup", ListElasticacheSubnetGroups,
+		withFilter())
 }
 
 func ListElasticacheSubnetGroups(sess *session.Session) ([]Resource, error) {
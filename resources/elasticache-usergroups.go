@@ -13,7 +13,8 @@ type ElasticacheUserGroup struct {
 }
 
 func init() {
-	register("ElasticacheUserGroup", ListElasticacheUserGroups)
+	register("ElasticacheUserGroup", ListElasticacheUserGroups,
+		withProperties("ID"))
 }
 
 func ListElasticacheUserGroups(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,9 @@ type ElasticacheUser struct {
 }
 
 func init() {
-	register("ElasticacheUser", ListElasticacheUsers)
+	register("ElasticacheUser", ListElasticacheUsers,
+		withProperties("ID", "UserName"),
+		withFilter())
 }
 
 func ListElasticacheUsers(sess *session.Session) ([]Resource, error) {
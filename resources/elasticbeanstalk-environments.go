@@ -14,7 +14,8 @@ type ElasticBeanstalkEnvironment struct {
 }
 
 func init() {
-	register("ElasticBeanstalkEnvironment", ListElasticBeanstalkEnvironments)
+	register("ElasticBeanstalkEnvironment", ListElasticBeanstalkEnvironments,
+		withProperties("Name"))
 }
 
 func ListElasticBeanstalkEnvironments(sess *session.Session) ([]Resource, error) {
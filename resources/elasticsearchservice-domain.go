@@ -13,7 +13,8 @@ type ESDomain struct {
 }
 
 func init() {
-	register("ESDomain", ListESDomains)
+	register("ESDomain", ListESDomains,
+		withProperties("tag:*"))
 }
 
 func ListESDomains(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type ELBLoadBalancer struct {
 }
 
 func init() {
-	register("ELB", ListELBLoadBalancers)
+	register("ELB", ListELBLoadBalancers,
+		withProperties("CreatedTime", "tag:*"))
 }
 
 func ListELBLoadBalancers(sess *session.Session) ([]Resource, error) {
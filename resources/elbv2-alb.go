@@ -19,7 +19,9 @@ type ELBv2LoadBalancer struct {
 }
 
 func init() {
-	register("ELBv2", ListELBv2LoadBalancers)
+	register("ELBv2", ListELBv2LoadBalancers,
+		withProperties("CreatedTime", "ARN", "tag:*"),
+		withFeatureFlags("disable-deletion-protection.ELBv2"))
 }
 
 func ListELBv2LoadBalancers(sess *session.Session) ([]Resource, error) {
@@ -20,7 +20,8 @@ type ELBv2ListenerRule struct {
 }
 
 func init() {
-	register("ELBv2ListenerRule", ListELBv2ListenerRules)
+	register("ELBv2ListenerRule", ListELBv2ListenerRules,
+		withProperties("ARN", "ListenerARN", "LoadBalancerName", "tag:*"))
 }
 
 func ListELBv2ListenerRules(sess *session.Session) ([]Resource, error) {
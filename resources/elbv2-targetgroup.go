@@ -13,7 +13,8 @@ type ELBv2TargetGroup struct {
 }
 
 func init() {
-	register("ELBv2TargetGroup", ListELBv2TargetGroups)
+	register("ELBv2TargetGroup", ListELBv2TargetGroups,
+		withProperties("ARN", "IsLoadBalanced", "tag:*"))
 }
 
 func ListELBv2TargetGroups(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,9 @@ type EMRCluster struct {
 }
 
 func init() {
-	register("EMRCluster", ListEMRClusters)
+	register("EMRCluster", ListEMRClusters,
+		withFilter(),
+		withProperties("CreatedTime"))
 }
 
 func ListEMRClusters(sess *session.Session) ([]Resource, error) {
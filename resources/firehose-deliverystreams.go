@@ -14,7 +14,8 @@ type FirehoseDeliveryStream struct {
 }
 
 func init() {
-	register("FirehoseDeliveryStream", ListFirehoseDeliveryStreams)
+	register("FirehoseDeliveryStream", ListFirehoseDeliveryStreams,
+		withProperties("Name", "tag:*"))
 }
 
 func ListFirehoseDeliveryStreams(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type FMSNotificationChannel struct {
 }
 
 func init() {
-	register("FMSNotificationChannel", ListFMSNotificationChannel)
+	register("FMSNotificationChannel", ListFMSNotificationChannel,
+		withProperties("NotificationChannelEnabled"))
 }
 
 func ListFMSNotificationChannel(sess *session.Session) ([]Resource, error) {
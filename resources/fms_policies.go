@@ -17,7 +17,8 @@ type FMSPolicy struct {
 }
 
 func init() {
-	register("FMSPolicy", ListFMSPolicies)
+	register("FMSPolicy", ListFMSPolicies,
+		withProperties("PolicyID", "PolicyName"))
 }
 
 func ListFMSPolicies(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type FSxBackup struct {
 }
 
 func init() {
-	register("FSxBackup", ListFSxBackups)
+	register("FSxBackup", ListFSxBackups,
+		withProperties("Type", "tag:*"))
 }
 
 func ListFSxBackups(sess *session.Session) ([]Resource, error) {
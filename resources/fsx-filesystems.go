@@ -13,7 +13,8 @@ type FSxFileSystem struct {
 }
 
 func init() {
-	register("FSxFileSystem", ListFSxFileSystems)
+	register("FSxFileSystem", ListFSxFileSystems,
+		withProperties("Type", "tag:*"))
 }
 
 func ListFSxFileSystems(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type GlobalAccelerator struct {
 }
 
 func init() {
-	register("GlobalAccelerator", ListGlobalAccelerators)
+	register("GlobalAccelerator", ListGlobalAccelerators,
+		withProperties("ARN"))
 }
 
 // ListGlobalAccelerators enumerates all available accelerators
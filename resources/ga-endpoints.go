@@ -14,7 +14,8 @@ type GlobalAcceleratorEndpointGroup struct {
 }
 
 func init() {
-	register("GlobalAcceleratorEndpointGroup", ListGlobalAcceleratorEndpointGroups)
+	register("GlobalAcceleratorEndpointGroup", ListGlobalAcceleratorEndpointGroups,
+		withProperties("ARN"))
 }
 
 // ListGlobalAcceleratorEndpointGroups enumerates all available accelerators
@@ -14,7 +14,8 @@ type GlobalAcceleratorListener struct {
 }
 
 func init() {
-	register("GlobalAcceleratorListener", ListGlobalAcceleratorListeners)
+	register("GlobalAcceleratorListener", ListGlobalAcceleratorListeners,
+		withProperties("ARN"))
 }
 
 // ListGlobalAcceleratorListeners enumerates all available listeners of all available accelerators
@@ -12,7 +12,8 @@ type GuardDutyDetector struct {
 }
 
 func init() {
-	register("GuardDutyDetector", ListGuardDutyDetectors)
+	register("GuardDutyDetector", ListGuardDutyDetectors,
+		withProperties("DetectorID"))
 }
 
 func ListGuardDutyDetectors(sess *session.Session) ([]Resource, error) {
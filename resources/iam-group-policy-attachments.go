@@ -16,7 +16,8 @@ type IAMGroupPolicyAttachment struct {
 }
 
 func init() {
-	register("IAMGroupPolicyAttachment", ListIAMGroupPolicyAttachments)
+	register("IAMGroupPolicyAttachment", ListIAMGroupPolicyAttachments,
+		withProperties("RoleName", "PolicyName", "PolicyArn"))
 }
 
 func ListIAMGroupPolicyAttachments(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type IAMGroup struct {
 }
 
 func init() {
-	register("IAMGroup", ListIAMGroups)
+	register("IAMGroup", ListIAMGroups,
+		withProperties("Name", "Path", "ID"))
 }
 
 func ListIAMGroups(sess *session.Session) ([]Resource, error) {
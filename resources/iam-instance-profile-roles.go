@@ -17,7 +17,8 @@ type IAMInstanceProfileRole struct {
 }
 
 func init() {
-	register("IAMInstanceProfileRole", ListIAMInstanceProfileRoles)
+	register("IAMInstanceProfileRole", ListIAMInstanceProfileRoles,
+		withProperties("InstanceProfile", "InstanceRole", "role:Path", "role:CreateDate", "role:LastUsedDate", "tag:*", "tag:role:*"))
 }
 
 func ListIAMInstanceProfileRoles(sess *session.Session) ([]Resource, error) {
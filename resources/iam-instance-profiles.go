@@ -15,7 +15,8 @@ type IAMInstanceProfile struct {
 }
 
 func init() {
-	register("IAMInstanceProfile", ListIAMInstanceProfiles)
+	register("IAMInstanceProfile", ListIAMInstanceProfiles,
+		withProperties("Name", "Path", "tag:*"))
 }
 
 func GetIAMInstanceProfile(svc *iam.IAM, instanceProfileName *string) (*iam.InstanceProfile, error) {
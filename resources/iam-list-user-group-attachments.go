@@ -16,7 +16,8 @@ type IAMUserGroupAttachment struct {
 }
 
 func init() {
-	register("IAMUserGroupAttachment", ListIAMUserGroupAttachments)
+	register("IAMUserGroupAttachment", ListIAMUserGroupAttachments,
+		withProperties("GroupName", "UserName", "tag:user:*"))
 }
 
 func ListIAMUserGroupAttachments(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type IAMLoginProfile struct {
 }
 
 func init() {
-	register("IAMLoginProfile", ListIAMLoginProfiles)
+	register("IAMLoginProfile", ListIAMLoginProfiles,
+		withProperties("UserName", "tag:user:*"))
 }
 
 func ListIAMLoginProfiles(sess *session.Session) ([]Resource, error) {
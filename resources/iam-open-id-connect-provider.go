@@ -13,7 +13,8 @@ type IAMOpenIDConnectProvider struct {
 }
 
 func init() {
-	register("IAMOpenIDConnectProvider", ListIAMOpenIDConnectProvider)
+	register("IAMOpenIDConnectProvider", ListIAMOpenIDConnectProvider,
+		withProperties("Arn", "tag:*"))
 }
 
 func ListIAMOpenIDConnectProvider(sess *session.Session) ([]Resource, error) {
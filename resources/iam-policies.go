@@ -18,7 +18,8 @@ type IAMPolicy struct {
 }
 
 func init() {
-	register("IAMPolicy", ListIAMPolicies)
+	register("IAMPolicy", ListIAMPolicies,
+		withProperties("Name", "ARN", "Path", "PolicyID", "tag:*"))
 }
 
 func GetIAMPolicy(svc *iam.IAM, policyArn *string) (*iam.Policy, error) {
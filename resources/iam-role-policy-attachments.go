@@ -19,7 +19,9 @@ type IAMRolePolicyAttachment struct {
 }
 
 func init() {
-	register("IAMRolePolicyAttachment", ListIAMRolePolicyAttachments)
+	register("IAMRolePolicyAttachment", ListIAMRolePolicyAttachments,
+		withProperties("RoleName", "RolePath", "RoleLastUsed", "RoleCreateDate", "PolicyName", "PolicyArn", "tag:role:*"),
+		withFilter())
 }
 
 func ListIAMRolePolicyAttachments(sess *session.Session) ([]Resource, error) {
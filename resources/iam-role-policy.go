@@ -20,7 +20,9 @@ type IAMRolePolicy struct {
 }
 
 func init() {
-	register("IAMRolePolicy", ListIAMRolePolicies)
+	register("IAMRolePolicy", ListIAMRolePolicies,
+		withProperties("PolicyName", "role:RoleName", "role:RoleID", "role:Path", "role:LastUsed", "role:CreateDate", "tag:role:*"),
+		withFilter())
 }
 
 func ListIAMRolePolicies(sess *session.Session) ([]Resource, error) {
@@ -19,7 +19,9 @@ type IAMRole struct {
 }
 
 func init() {
-	register("IAMRole", ListIAMRoles)
+	register("IAMRole", ListIAMRoles,
+		withProperties("CreateDate", "LastUsedDate", "Name", "Path", "tag:*"),
+		withFilter())
 }
 
 func GetIAMRole(svc *iam.IAM, roleName *string) (*iam.Role, error) {
@@ -104,6 +106,10 @@ func (role *IAMRole) Properties() types.Properties {
 	return properties
 }
 
+func (role *IAMRole) CreatedAt() *time.Time {
+	return role.role.CreateDate
+}
+
 func (e *IAMRole) String() string {
 	return e.name
 }
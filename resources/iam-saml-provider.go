@@ -13,7 +13,8 @@ type IAMSAMLProvider struct {
 }
 
 func init() {
-	register("IAMSAMLProvider", ListIAMSAMLProvider)
+	register("IAMSAMLProvider", ListIAMSAMLProvider,
+		withProperties("Arn", "tag:*"))
 }
 
 func ListIAMSAMLProvider(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type IAMServiceSpecificCredential struct {
 }
 
 func init() {
-	register("IAMServiceSpecificCredential", ListServiceSpecificCredentials)
+	register("IAMServiceSpecificCredential", ListServiceSpecificCredentials,
+		withProperties("ServiceName", "ID", "tag:user:*"))
 }
 
 func ListServiceSpecificCredentials(sess *session.Session) ([]Resource, error) {
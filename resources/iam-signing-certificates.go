@@ -18,7 +18,8 @@ type IAMSigningCertificate struct {
 }
 
 func init() {
-	register("IAMSigningCertificate", ListIAMSigningCertificates)
+	register("IAMSigningCertificate", ListIAMSigningCertificates,
+		withProperties("UserName", "CertificateId", "Status", "tag:user:*"))
 }
 
 func ListIAMSigningCertificates(sess *session.Session) ([]Resource, error) {
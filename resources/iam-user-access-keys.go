@@ -19,7 +19,8 @@ type IAMUserAccessKey struct {
 }
 
 func init() {
-	register("IAMUserAccessKey", ListIAMUserAccessKeys)
+	register("IAMUserAccessKey", ListIAMUserAccessKeys,
+		withProperties("UserName", "AccessKeyID", "CreateDate", "tag:*"))
 }
 
 func ListIAMUserAccessKeys(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,8 @@ type IAMUserPolicyAttachment struct {
 }
 
 func init() {
-	register("IAMUserPolicyAttachment", ListIAMUserPolicyAttachments)
+	register("IAMUserPolicyAttachment", ListIAMUserPolicyAttachments,
+		withProperties("PolicyArn", "PolicyName", "UserName", "tag:user:*"))
 }
 
 func ListIAMUserPolicyAttachments(sess *session.Session) ([]Resource, error) {
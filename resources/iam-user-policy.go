@@ -16,7 +16,8 @@ type IAMUserPolicy struct {
 }
 
 func init() {
-	register("IAMUserPolicy", ListIAMUserPolicies)
+	register("IAMUserPolicy", ListIAMUserPolicies,
+		withProperties("PolicyName", "user:Arn", "user:UserName", "user:UserID", "tag:user:*"))
 }
 
 func ListIAMUserPolicies(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type UserSSHKey struct {
 }
 
 func init() {
-	register("IAMUserSSHPublicKey", ListIAMUserSSHPublicKeys)
+	register("IAMUserSSHPublicKey", ListIAMUserSSHPublicKeys,
+		withProperties("UserName", "SSHKeyID"))
 }
 
 func ListIAMUserSSHPublicKeys(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,8 @@ type IAMUser struct {
 }
 
 func init() {
-	register("IAMUser", ListIAMUsers)
+	register("IAMUser", ListIAMUsers,
+		withProperties("Name", "CreateDate", "PasswordLastUsed", "tag:*"))
 }
 
 func GetIAMUser(svc *iam.IAM, userName *string) (*iam.User, error) {
@@ -68,6 +69,10 @@ func (e *IAMUser) Remove() error {
 	return nil
 }
 
+func (e *IAMUser) CreatedAt() *time.Time {
+	return e.createDate
+}
+
 func (e *IAMUser) String() string {
 	return e.name
 }
@@ -18,7 +18,9 @@ type IAMVirtualMFADevice struct {
 }
 
 func init() {
-	register("IAMVirtualMFADevice", ListIAMVirtualMFADevices)
+	register("IAMVirtualMFADevice", ListIAMVirtualMFADevices,
+		withProperties("Serial", "UserName", "tag:user:*"),
+		withFilter())
 }
 
 func ListIAMVirtualMFADevices(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,8 @@ type ImageBuilderComponent struct {
 }
 
 func init() {
-	register("ImageBuilderComponent", ListImageBuilderComponents)
+	register("ImageBuilderComponent", ListImageBuilderComponents,
+		withProperties("arn"))
 }
 
 func ListImageBuilderComponents(sess *session.Session) ([]Resource, error) {
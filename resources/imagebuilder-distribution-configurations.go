@@ -12,7 +12,8 @@ type ImageBuilderDistributionConfiguration struct {
 }
 
 func init() {
-	register("ImageBuilderDistributionConfiguration", ListImageBuilderDistributionConfigurations)
+	register("ImageBuilderDistributionConfiguration", ListImageBuilderDistributionConfigurations,
+		withProperties("arn"))
 }
 
 func ListImageBuilderDistributionConfigurations(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,8 @@ type ImageBuilderImage struct {
 }
 
 func init() {
-	register("ImageBuilderImage", ListImageBuilderImages)
+	register("ImageBuilderImage", ListImageBuilderImages,
+		withProperties("arn"))
 }
 
 func ListImageBuilderImages(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,8 @@ type ImageBuilderInfrastructureConfiguration struct {
 }
 
 func init() {
-	register("ImageBuilderInfrastructureConfiguration", ListImageBuilderInfrastructureConfigurations)
+	register("ImageBuilderInfrastructureConfiguration", ListImageBuilderInfrastructureConfigurations,
+		withProperties("arn"))
 }
 
 func ListImageBuilderInfrastructureConfigurations(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,8 @@ type ImageBuilderPipeline struct {
 }
 
 func init() {
-	register("ImageBuilderPipeline", ListImageBuilderPipelines)
+	register("ImageBuilderPipeline", ListImageBuilderPipelines,
+		withProperties("arn"))
 }
 
 func ListImageBuilderPipelines(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,8 @@ type ImageBuilderRecipe struct {
 }
 
 func init() {
-	register("ImageBuilderRecipe", ListImageBuilderRecipes)
+	register("ImageBuilderRecipe", ListImageBuilderRecipes,
+		withProperties("arn"))
 }
 
 func ListImageBuilderRecipes(sess *session.Session) ([]Resource, error) {
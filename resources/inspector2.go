@@ -13,7 +13,8 @@ type Inspector2 struct {
 }
 
 func init() {
-	register("Inspector2", ListInspector2)
+	register("Inspector2", ListInspector2,
+		withProperties("AccountID"))
 }
 
 func ListInspector2(sess *session.Session) ([]Resource, error) {
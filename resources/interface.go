@@ -1,8 +1,12 @@
 package resources
 
 import (
+	"context"
 	"fmt"
+	"runtime/debug"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/rebuy-de/aws-nuke/v2/pkg/config"
@@ -13,10 +17,22 @@ type ResourceListers map[string]ResourceLister
 
 type ResourceLister func(s *session.Session) ([]Resource, error)
 
+// ContextLister is a ResourceLister which stops listing, when the context is
+// cancelled or its deadline is exceeded.
+type ContextLister func(ctx context.Context, s *session.Session) ([]Resource, error)
+
 type Resource interface {
 	Remove() error
 }
 
+// RemoverWithContext is implemented by resources whose removal can be
+// cancelled. This is mostly useful for long running removals, like emptying
+// a bucket or waiting for a stack deletion.
+type RemoverWithContext interface {
+	Resource
+	RemoveWithContext(ctx context.Context) error
+}
+
 type Filter interface {
 	Resource
 	Filter() error
@@ -37,7 +53,59 @@ type FeatureFlagGetter interface {
 	FeatureFlags(config.FeatureFlags)
 }
 
-var resourceListers = make(ResourceListers)
+// DependencyGetter is implemented by resources which cannot be removed while
+// resources of other types still exist in the same region. DependsOn returns
+// the names of those resource types.
+type DependencyGetter interface {
+	Resource
+	DependsOn() []string
+}
+
+// CreatedAtGetter is implemented by resources which know their creation
+// time. It returns nil, if the time is unknown for a single resource.
+type CreatedAtGetter interface {
+	Resource
+	CreatedAt() *time.Time
+}
+
+// Snapshotter is implemented by resources holding data, which can be saved
+// before the removal. Snapshot starts the snapshot and returns its ARN as
+// soon as it is created. An empty ARN means that the data is saved by another
+// resource, eg the cluster of an instance. WaitForSnapshot blocks until the
+// snapshot is usable. Both are separate, so a retry after an interrupted wait
+// does not try to create the snapshot a second time.
+type Snapshotter interface {
+	Resource
+	Snapshot(ctx context.Context, opts SnapshotOptions) (string, error)
+	WaitForSnapshot(ctx context.Context, arn string, opts SnapshotOptions) error
+}
+
+type SnapshotOptions struct {
+	// Name is the prefix for the snapshot name. Resources append their own
+	// identifier to make it unique.
+	Name string
+	Tags map[string]string
+
+	// BackupVault and BackupRoleARN are used by resources without native
+	// snapshots, which are backed up with AWS Backup instead.
+	BackupVault   string
+	BackupRoleARN string
+}
+
+var (
+	resourceListers = make(ResourceListers)
+	contextListers  = map[string]ContextLister{}
+)
+
+// registerWithContext registers a lister which supports cancellation. The
+// resource is still available through GetLister for callers without context.
+func registerWithContext(name string, lister ContextLister, opts ...registerOption) {
+	register(name, func(s *session.Session) ([]Resource, error) {
+		return lister(context.Background(), s)
+	}, opts...)
+
+	contextListers[name] = lister
+}
 
 func register(name string, lister ResourceLister, opts ...registerOption) {
 	_, exists := resourceListers[name]
@@ -46,6 +114,7 @@ func register(name string, lister ResourceLister, opts ...registerOption) {
 	}
 
 	resourceListers[name] = lister
+	resourceSchemas[name] = &ResourceSchema{Name: name}
 
 	for _, opt := range opts {
 		opt(name, lister)
@@ -68,9 +137,99 @@ func mapCloudControl(typeName string) registerOption {
 		}
 
 		cloudControlMapping[typeName] = name
+		resourceSchemas[name].CloudControl = typeName
 	}
 }
 
+// ResourceSchema describes a resource type as it was declared on
+// registration, so it can be inspected without listing any resources.
+type ResourceSchema struct {
+	Name string
+
+	// Properties is nil, if the resource type did not declare its
+	// properties. A trailing "*" stands for any suffix, eg "tag:*" for all
+	// tags.
+	Properties []string
+
+	// Filter is true, if the resource type skips some resources by itself,
+	// eg default VPCs or resources managed by AWS.
+	Filter bool
+
+	// FeatureFlags contains the config keys of the feature flags, which
+	// change how the resources get removed.
+	FeatureFlags []string
+
+	// CloudControl is the Cloud Control type name, which can replace the
+	// resource type.
+	CloudControl string
+}
+
+var resourceSchemas = map[string]*ResourceSchema{}
+
+// withProperties declares which properties the resource sets in Properties,
+// so filters can be checked without listing any resources. A trailing "*"
+// stands for any suffix, eg "tag:*" for all tags.
+func withProperties(properties ...string) registerOption {
+	return func(name string, lister ResourceLister) {
+		resourceSchemas[name].Properties = properties
+	}
+}
+
+// withFilter declares that the resource implements Filter.
+func withFilter() registerOption {
+	return func(name string, lister ResourceLister) {
+		resourceSchemas[name].Filter = true
+	}
+}
+
+// withFeatureFlags declares which feature flags the resource honors. The
+// flags are named by their config keys, eg
+// "disable-deletion-protection.RDSInstance".
+func withFeatureFlags(flags ...string) registerOption {
+	return func(name string, lister ResourceLister) {
+		resourceSchemas[name].FeatureFlags = flags
+	}
+}
+
+// GetSchema returns the schema of the resource type or nil, if there is no
+// resource type with this name.
+func GetSchema(name string) *ResourceSchema {
+	return resourceSchemas[name]
+}
+
+// GetProperties returns the declared properties of the resource type. The
+// second return value is false, if the resource type did not declare them.
+func GetProperties(name string) ([]string, bool) {
+	schema, ok := resourceSchemas[name]
+	if !ok || schema.Properties == nil {
+		return nil, false
+	}
+
+	return schema.Properties, true
+}
+
+// HasProperty checks whether the resource type declared the property. It
+// also returns true, if the resource type did not declare its properties at
+// all, since nothing is known about it.
+func HasProperty(name, property string) bool {
+	properties, ok := GetProperties(name)
+	if !ok {
+		return true
+	}
+
+	for _, p := range properties {
+		if p == property {
+			return true
+		}
+
+		if strings.HasSuffix(p, "*") && strings.HasPrefix(property, strings.TrimSuffix(p, "*")) {
+			return true
+		}
+	}
+
+	return false
+}
+
 func GetLister(name string) ResourceLister {
 	if strings.HasPrefix(name, "AWS::") {
 		return NewListCloudControlResource(name)
@@ -78,6 +237,106 @@ func GetLister(name string) ResourceLister {
 	return resourceListers[name]
 }
 
+// GetContextLister returns a lister which respects the context. Listers which
+// do not support cancellation by themselves are run in the background, so at
+// least the caller does not get blocked by them. The background call still
+// runs to its end, but its result is dropped.
+func GetContextLister(name string) ContextLister {
+	lister, ok := contextListers[name]
+	if ok {
+		return lister
+	}
+
+	legacy := GetLister(name)
+	if legacy == nil {
+		return nil
+	}
+
+	return func(ctx context.Context, s *session.Session) ([]Resource, error) {
+		return runWithContext(ctx, func() ([]Resource, error) {
+			return legacy(s)
+		})
+	}
+}
+
+// removals contains the removals of resources without support for
+// cancellation, which were started in the background and whose result was
+// not picked up yet, since the context of the caller was done first.
+var (
+	removals     = map[Resource]<-chan result[struct{}]{}
+	removalsLock sync.Mutex
+)
+
+// RemoveWithContext removes the resource and respects the context. Like for
+// listers, resources without support for cancellation are removed in the
+// background. If such a removal is still running from a previous call, which
+// timed out, it is not started a second time, but the call waits for the
+// running one instead.
+func RemoveWithContext(ctx context.Context, r Resource) error {
+	remover, ok := r.(RemoverWithContext)
+	if ok {
+		return remover.RemoveWithContext(ctx)
+	}
+
+	removalsLock.Lock()
+	done, ok := removals[r]
+	if !ok {
+		done = runInBackground(func() (struct{}, error) {
+			return struct{}{}, r.Remove()
+		})
+		removals[r] = done
+	}
+	removalsLock.Unlock()
+
+	select {
+	case res := <-done:
+		removalsLock.Lock()
+		delete(removals, r)
+		removalsLock.Unlock()
+		return res.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+type result[T any] struct {
+	value T
+	err   error
+}
+
+// runWithContext waits for fn, which runs in the background, or until the
+// context is done.
+func runWithContext[T any](ctx context.Context, fn func() (T, error)) (T, error) {
+	select {
+	case res := <-runInBackground(fn):
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero T
+		return zero, ctx.Err()
+	}
+}
+
+// runInBackground runs fn in another goroutine. Its result is sent over the
+// returned channel, which is buffered, so the goroutine ends even if nobody
+// waits for it anymore.
+func runInBackground[T any](fn func() (T, error)) <-chan result[T] {
+	done := make(chan result[T], 1)
+	go func() {
+		defer func() {
+			// Panics cannot be recovered by the caller, since they happen
+			// in another goroutine.
+			if r := recover(); r != nil {
+				done <- result[T]{err: fmt.Errorf("%v\n\n%s", r, string(debug.Stack()))}
+			}
+		}()
+
+		value, err := fn()
+		done <- result[T]{value: value, err: err}
+	}()
+
+	return done
+}
+
 func GetListerNames() []string {
 	names := []string{}
 	for resourceType := range resourceListers {
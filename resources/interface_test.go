@@ -0,0 +1,105 @@
+package resources
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// TestSchemaMatchesMethods makes sure that the schema declared on
+// registration stays in sync with the methods of the resources. Every file
+// registers exactly one resource type, so the methods of a file belong to the
+// registered type.
+func TestSchemaMatchesMethods(t *testing.T) {
+	paths, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range paths {
+		if strings.HasSuffix(path, "_test.go") {
+			continue
+		}
+
+		fset := token.NewFileSet()
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		methods := map[string]bool{}
+		options := map[string]bool{}
+		names := []string{}
+
+		ast.Inspect(file, func(n ast.Node) bool {
+			switch n := n.(type) {
+			case *ast.FuncDecl:
+				if n.Recv != nil {
+					methods[n.Name.Name] = true
+				}
+
+			case *ast.CallExpr:
+				ident, ok := n.Fun.(*ast.Ident)
+				if !ok {
+					return true
+				}
+
+				switch ident.Name {
+				case "register", "registerWithContext":
+					lit, ok := n.Args[0].(*ast.BasicLit)
+					if ok {
+						names = append(names, strings.Trim(lit.Value, `"`))
+					}
+				case "withProperties", "withFilter", "withFeatureFlags":
+					options[ident.Name] = true
+				}
+			}
+			return true
+		})
+
+		if len(names) != 1 {
+			continue
+		}
+
+		for method, option := range map[string]string{
+			"Properties":   "withProperties",
+			"Filter":       "withFilter",
+			"FeatureFlags": "withFeatureFlags",
+		} {
+			if methods[method] != options[option] {
+				t.Errorf("%s: %s implements %s: %t, but declares %s: %t",
+					path, names[0], method, methods[method], option, options[option])
+			}
+		}
+	}
+}
+
+func TestHasProperty(t *testing.T) {
+	cases := []struct {
+		resourceType string
+		property     string
+		want         bool
+	}{
+		{"EC2VPC", "IsDefault", true},
+		{"EC2VPC", "tag:Name", true},
+		{"EC2VPC", "tag:", true},
+		{"EC2VPC", "Isdefault", false},
+		{"EC2VPC", "Name", false},
+		{"IAMRolePolicy", "role:Path", true},
+		{"IAMRolePolicy", "tag:role:Owner", true},
+		{"IAMRolePolicy", "tag:Owner", false},
+		{"AWS::Foo::Bar", "anything", true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.resourceType+"/"+tc.property, func(t *testing.T) {
+			have := HasProperty(tc.resourceType, tc.property)
+			if have != tc.want {
+				t.Errorf("HasProperty(%q, %q) = %t, want %t", tc.resourceType, tc.property, have, tc.want)
+			}
+		})
+	}
+}
@@ -17,7 +17,8 @@ type IoTThingTypeState struct {
 }
 
 func init() {
-	register("IoTThingTypeState", ListIoTThingTypeStates)
+	register("IoTThingTypeState", ListIoTThingTypeStates,
+		withFilter())
 }
 
 func ListIoTThingTypeStates(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type KendraIndex struct {
 }
 
 func init() {
-	register("KendraIndex", ListKendraIndexes)
+	register("KendraIndex", ListKendraIndexes,
+		withProperties("Name"))
 }
 
 func ListKendraIndexes(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,9 @@ type KMSAlias struct {
 }
 
 func init() {
-	register("KMSAlias", ListKMSAliases)
+	register("KMSAlias", ListKMSAliases,
+		withFilter(),
+		withProperties("Name"))
 }
 
 func ListKMSAliases(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,9 @@ type KMSKey struct {
 }
 
 func init() {
-	register("KMSKey", ListKMSKeys)
+	register("KMSKey", ListKMSKeys,
+		withProperties("ID", "tag:*"),
+		withFilter())
 }
 
 func ListKMSKeys(sess *session.Session) ([]Resource, error) {
@@ -12,7 +12,8 @@ type LambdaEventSourceMapping struct {
 }
 
 func init() {
-	register("LambdaEventSourceMapping", ListLambdaEventSourceMapping)
+	register("LambdaEventSourceMapping", ListLambdaEventSourceMapping,
+		withProperties("UUID", "EventSourceArn", "FunctionArn", "State"))
 }
 
 func ListLambdaEventSourceMapping(sess *session.Session) ([]Resource, error) {
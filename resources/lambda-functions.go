@@ -13,7 +13,8 @@ type LambdaFunction struct {
 }
 
 func init() {
-	register("LambdaFunction", ListLambdaFunctions)
+	register("LambdaFunction", ListLambdaFunctions,
+		withProperties("Name", "tag:*"))
 }
 
 func ListLambdaFunctions(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type lambdaLayer struct {
 }
 
 func init() {
-	register("LambdaLayer", ListLambdaLayers)
+	register("LambdaLayer", ListLambdaLayers,
+		withProperties("Name", "Version"))
 }
 
 func ListLambdaLayers(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type LexBot struct {
 }
 
 func init() {
-	register("LexBot", ListLexBots)
+	register("LexBot", ListLexBots,
+		withProperties("Name", "Status"))
 }
 
 func ListLexBots(sess *session.Session) ([]Resource, error) {
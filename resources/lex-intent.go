@@ -13,7 +13,8 @@ type LexIntent struct {
 }
 
 func init() {
-	register("LexIntent", ListLexIntents)
+	register("LexIntent", ListLexIntents,
+		withProperties("Name"))
 }
 
 func ListLexIntents(sess *session.Session) ([]Resource, error) {
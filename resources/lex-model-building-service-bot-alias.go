@@ -15,7 +15,8 @@ type LexModelBuildingServiceBotAlias struct {
 }
 
 func init() {
-	register("LexModelBuildingServiceBotAlias", ListLexModelBuildingServiceBotAliases)
+	register("LexModelBuildingServiceBotAlias", ListLexModelBuildingServiceBotAliases,
+		withProperties("Name", "BotName", "Checksum"))
 }
 
 func ListLexModelBuildingServiceBotAliases(sess *session.Session) ([]Resource, error) {
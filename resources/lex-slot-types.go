@@ -13,7 +13,8 @@ type LexSlotType struct {
 }
 
 func init() {
-	register("LexSlotType", ListLexSlotTypes)
+	register("LexSlotType", ListLexSlotTypes,
+		withProperties("Name"))
 }
 
 func ListLexSlotTypes(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,9 @@ type LightsailInstance struct {
 }
 
 func init() {
-	register("LightsailInstance", ListLightsailInstances)
+	register("LightsailInstance", ListLightsailInstances,
+		withProperties("Name", "tag:*"),
+		withFeatureFlags("force-delete-lightsail-addons"))
 }
 
 func ListLightsailInstances(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type AMGWorkspace struct {
 }
 
 func init() {
-	register("AMGWorkspace", ListAMGWorkspaces)
+	register("AMGWorkspace", ListAMGWorkspaces,
+		withProperties("WorkspaceId", "WorkspaceName"))
 }
 
 func ListAMGWorkspaces(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type MediaConvertQueue struct {
 }
 
 func init() {
-	register("MediaConvertQueue", ListMediaConvertQueues)
+	register("MediaConvertQueue", ListMediaConvertQueues,
+		withFilter())
 }
 
 func ListMediaConvertQueues(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,9 @@ type MemoryDBACL struct {
 }
 
 func init() {
-	register("MemoryDBACL", ListMemoryDBACLs)
+	register("MemoryDBACL", ListMemoryDBACLs,
+		withProperties("Name", "tag:*"),
+		withFilter())
 }
 
 func ListMemoryDBACLs(sess *session.Session) ([]Resource, error) {
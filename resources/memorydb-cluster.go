@@ -14,7 +14,8 @@ type MemoryDBCluster struct {
 }
 
 func init() {
-	register("MemoryDBCluster", ListMemoryDbClusters)
+	register("MemoryDBCluster", ListMemoryDbClusters,
+		withProperties("Name", "tag:*"))
 }
 
 func ListMemoryDbClusters(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,9 @@ type MemoryDBParameterGroup struct {
 }
 
 func init() {
-	register("MemoryDBParameterGroup", ListMemoryDBParameterGroups)
+	register("MemoryDBParameterGroup", ListMemoryDBParameterGroups,
+		withProperties("Name", "Family", "tag:*"),
+		withFilter())
 }
 
 func ListMemoryDBParameterGroups(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type MemoryDBSubnetGroup struct {
 }
 
 func init() {
-	register("MemoryDBSubnetGroup", ListMemoryDBSubnetGroups)
+	register("MemoryDBSubnetGroup", ListMemoryDBSubnetGroups,
+		withProperties("Name", "tag:*"))
 }
 
 func ListMemoryDBSubnetGroups(sess *session.Session) ([]Resource, error) {
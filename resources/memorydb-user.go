@@ -17,7 +17,9 @@ type MemoryDBUser struct {
 }
 
 func init() {
-	register("MemoryDBUser", ListMemoryDBUsers)
+	register("MemoryDBUser", ListMemoryDBUsers,
+		withProperties("Name", "tag:*"),
+		withFilter())
 }
 
 func ListMemoryDBUsers(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type MGNJob struct {
 }
 
 func init() {
-	register("MGNJob", ListMGNJobs)
+	register("MGNJob", ListMGNJobs,
+		withProperties("JobID", "ARN", "tag:*"))
 }
 
 func ListMGNJobs(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type MGNSourceServer struct {
 }
 
 func init() {
-	register("MGNSourceServer", ListMGNSourceServers)
+	register("MGNSourceServer", ListMGNSourceServers,
+		withProperties("SourceServerID", "ARN", "tag:*"))
 }
 
 func ListMGNSourceServers(sess *session.Session) ([]Resource, error) {
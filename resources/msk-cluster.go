@@ -15,7 +15,8 @@ type MSKCluster struct {
 }
 
 func init() {
-	register("MSKCluster", ListMSKCluster)
+	register("MSKCluster", ListMSKCluster,
+		withProperties("ARN", "Name", "tag:*"))
 }
 
 func ListMSKCluster(sess *session.Session) ([]Resource, error) {
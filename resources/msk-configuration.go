@@ -13,7 +13,8 @@ type MSKConfiguration struct {
 }
 
 func init() {
-	register("MSKConfiguration", ListMSKConfigurations)
+	register("MSKConfiguration", ListMSKConfigurations,
+		withProperties("ARN", "Name"))
 }
 
 func ListMSKConfigurations(sess *session.Session) ([]Resource, error) {
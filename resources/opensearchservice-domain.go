@@ -16,7 +16,8 @@ type OSDomain struct {
 }
 
 func init() {
-	register("OSDomain", ListOSDomains)
+	register("OSDomain", ListOSDomains,
+		withProperties("LastUpdatedTime", "tag:*"))
 }
 
 func ListOSDomains(sess *session.Session) ([]Resource, error) {
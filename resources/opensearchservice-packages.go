@@ -18,7 +18,9 @@ type OSPackage struct {
 }
 
 func init() {
-	register("OSPackage", ListOSPackages)
+	register("OSPackage", ListOSPackages,
+		withProperties("PackageID", "PackageName", "CreatedTime"),
+		withFilter())
 }
 
 func ListOSPackages(sess *session.Session) ([]Resource, error) {
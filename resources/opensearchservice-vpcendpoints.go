@@ -12,7 +12,8 @@ type OSVPCEndpoint struct {
 }
 
 func init() {
-	register("OSVPCEndpoint", ListOSVPCEndpoints)
+	register("OSVPCEndpoint", ListOSVPCEndpoints,
+		withProperties("VpcEndpointId"))
 }
 
 func ListOSVPCEndpoints(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type OpsWorksUserProfile struct {
 }
 
 func init() {
-	register("OpsWorksUserProfile", ListOpsWorksUserProfiles)
+	register("OpsWorksUserProfile", ListOpsWorksUserProfiles,
+		withFilter())
 }
 
 func ListOpsWorksUserProfiles(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type OpsWorksCMServerState struct {
 }
 
 func init() {
-	register("OpsWorksCMServerState", ListOpsWorksCMServerStates)
+	register("OpsWorksCMServerState", ListOpsWorksCMServerStates,
+		withFilter())
 }
 
 func ListOpsWorksCMServerStates(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type AMPWorkspace struct {
 
 func init() {
 	register("AMPWorkspace", ListAMPWorkspaces,
-		mapCloudControl("AWS::APS::Workspace"))
+		mapCloudControl("AWS::APS::Workspace"),
+		withProperties("WorkspaceAlias", "WorkspaceARN", "WorkspaceId"))
 }
 
 func ListAMPWorkspaces(sess *session.Session) ([]Resource, error) {
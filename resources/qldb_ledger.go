@@ -18,7 +18,9 @@ type QLDBLedger struct {
 }
 
 func init() {
-	register("QLDBLedger", ListQLDBLedgers)
+	register("QLDBLedger", ListQLDBLedgers,
+		withProperties("Name", "DeletionProtection", "Arn", "CreationDateTime", "State", "PermissionsMode", "EncryptionDescription"),
+		withFeatureFlags("disable-deletion-protection.QLDBLedger"))
 }
 
 func ListQLDBLedgers(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,9 @@ type RDSClusterSnapshot struct {
 }
 
 func init() {
-	register("RDSClusterSnapshot", ListRDSClusterSnapshots)
+	register("RDSClusterSnapshot", ListRDSClusterSnapshots,
+		withProperties("ARN", "Identifier", "SnapshotType", "Status", "tag:*"),
+		withFilter())
 }
 
 func ListRDSClusterSnapshots(sess *session.Session) ([]Resource, error) {
@@ -1,6 +1,8 @@
 package resources
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/rds"
@@ -15,7 +17,8 @@ type RDSDBCluster struct {
 }
 
 func init() {
-	register("RDSDBCluster", ListRDSClusters)
+	register("RDSDBCluster", ListRDSClusters,
+		withProperties("Identifier", "Deletion Protection", "tag:*"))
 }
 
 func ListRDSClusters(sess *session.Session) ([]Resource, error) {
@@ -73,6 +76,32 @@ func (i *RDSDBCluster) Remove() error {
 	return nil
 }
 
+func (i *RDSDBCluster) Snapshot(ctx context.Context, opts SnapshotOptions) (string, error) {
+	id := snapshotName(opts, i.id)
+
+	tags := []*rds.Tag{}
+	for _, key := range sortedTagKeys(opts.Tags) {
+		tags = append(tags, &rds.Tag{Key: aws.String(key), Value: aws.String(opts.Tags[key])})
+	}
+
+	resp, err := i.svc.CreateDBClusterSnapshotWithContext(ctx, &rds.CreateDBClusterSnapshotInput{
+		DBClusterIdentifier:         &i.id,
+		DBClusterSnapshotIdentifier: aws.String(id),
+		Tags:                        tags,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(resp.DBClusterSnapshot.DBClusterSnapshotArn), nil
+}
+
+func (i *RDSDBCluster) WaitForSnapshot(ctx context.Context, arn string, opts SnapshotOptions) error {
+	return i.svc.WaitUntilDBClusterSnapshotAvailableWithContext(ctx, &rds.DescribeDBClusterSnapshotsInput{
+		DBClusterSnapshotIdentifier: aws.String(arnResourceName(arn)),
+	})
+}
+
 func (i *RDSDBCluster) String() string {
 	return i.id
 }
@@ -17,7 +17,9 @@ type RDSDBClusterParameterGroup struct {
 }
 
 func init() {
-	register("RDSDBClusterParameterGroup", ListRDSClusterParameterGroups)
+	register("RDSDBClusterParameterGroup", ListRDSClusterParameterGroups,
+		withProperties("Name", "tag:*"),
+		withFilter())
 }
 
 func ListRDSClusterParameterGroups(sess *session.Session) ([]Resource, error) {
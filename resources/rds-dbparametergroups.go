@@ -17,7 +17,9 @@ type RDSDBParameterGroup struct {
 }
 
 func init() {
-	register("RDSDBParameterGroup", ListRDSParameterGroups)
+	register("RDSDBParameterGroup", ListRDSParameterGroups,
+		withProperties("Name", "tag:*"),
+		withFilter())
 }
 
 func ListRDSParameterGroups(sess *session.Session) ([]Resource, error) {
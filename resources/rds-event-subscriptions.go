@@ -15,7 +15,8 @@ type RDSEventSubscription struct {
 }
 
 func init() {
-	register("RDSEventSubscription", ListRDSEventSubscriptions)
+	register("RDSEventSubscription", ListRDSEventSubscriptions,
+		withProperties("ID", "Enabled", "tag:*"))
 }
 
 func ListRDSEventSubscriptions(sess *session.Session) ([]Resource, error) {
@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -19,7 +20,9 @@ type RDSInstance struct {
 }
 
 func init() {
-	register("RDSInstance", ListRDSInstances)
+	register("RDSInstance", ListRDSInstances,
+		withProperties("Identifier", "DeletionProtection", "AvailabilityZone", "InstanceClass", "Engine", "EngineVersion", "MultiAZ", "PubliclyAccessible", "InstanceCreateTime", "tag:*"),
+		withFeatureFlags("disable-deletion-protection.RDSInstance"))
 }
 
 func ListRDSInstances(sess *session.Session) ([]Resource, error) {
@@ -80,6 +83,39 @@ func (i *RDSInstance) Remove() error {
 	return nil
 }
 
+// Snapshot creates a DB snapshot of the instance. Instances of an Aurora
+// cluster cannot be snapshotted on their own, but their data is covered by
+// the snapshot of RDSDBCluster, so they are skipped.
+func (i *RDSInstance) Snapshot(ctx context.Context, opts SnapshotOptions) (string, error) {
+	if i.instance.DBClusterIdentifier != nil {
+		return "", nil
+	}
+
+	id := snapshotName(opts, aws.StringValue(i.instance.DBInstanceIdentifier))
+
+	tags := []*rds.Tag{}
+	for _, key := range sortedTagKeys(opts.Tags) {
+		tags = append(tags, &rds.Tag{Key: aws.String(key), Value: aws.String(opts.Tags[key])})
+	}
+
+	resp, err := i.svc.CreateDBSnapshotWithContext(ctx, &rds.CreateDBSnapshotInput{
+		DBInstanceIdentifier: i.instance.DBInstanceIdentifier,
+		DBSnapshotIdentifier: aws.String(id),
+		Tags:                 tags,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(resp.DBSnapshot.DBSnapshotArn), nil
+}
+
+func (i *RDSInstance) WaitForSnapshot(ctx context.Context, arn string, opts SnapshotOptions) error {
+	return i.svc.WaitUntilDBSnapshotAvailableWithContext(ctx, &rds.DescribeDBSnapshotsInput{
+		DBSnapshotIdentifier: aws.String(arnResourceName(arn)),
+	})
+}
+
 func (i *RDSInstance) Properties() types.Properties {
 	properties := types.NewProperties().
 		Set("Identifier", i.instance.DBInstanceIdentifier).
@@ -102,6 +138,10 @@ func (i *RDSInstance) Properties() types.Properties {
 	return properties
 }
 
+func (i *RDSInstance) CreatedAt() *time.Time {
+	return i.instance.InstanceCreateTime
+}
+
 func (i *RDSInstance) String() string {
 	return aws.StringValue(i.instance.DBInstanceIdentifier)
 }
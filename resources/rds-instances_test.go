@@ -0,0 +1,64 @@
+package resources
+
+import (
+	"context"
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRDSInstancesSnapshot(t *testing.T) {
+	server := awstest.NewServer(t)
+	server.Handle("DescribeDBInstances",
+		awstest.XML(`<DescribeDBInstancesResponse><DescribeDBInstancesResult><DBInstances>
+			<DBInstance>
+				<DBInstanceIdentifier>standalone</DBInstanceIdentifier>
+				<DBInstanceArn>arn:aws:rds:eu-west-1:123456789012:db:standalone</DBInstanceArn>
+			</DBInstance>
+			<DBInstance>
+				<DBInstanceIdentifier>aurora-1</DBInstanceIdentifier>
+				<DBInstanceArn>arn:aws:rds:eu-west-1:123456789012:db:aurora-1</DBInstanceArn>
+				<DBClusterIdentifier>aurora</DBClusterIdentifier>
+			</DBInstance>
+		</DBInstances></DescribeDBInstancesResult></DescribeDBInstancesResponse>`))
+	server.Handle("ListTagsForResource",
+		awstest.XML(`<ListTagsForResourceResponse><ListTagsForResourceResult><TagList/></ListTagsForResourceResult></ListTagsForResourceResponse>`))
+	server.Handle("CreateDBSnapshot",
+		awstest.XML(`<CreateDBSnapshotResponse><CreateDBSnapshotResult><DBSnapshot>
+			<DBSnapshotArn>arn:aws:rds:eu-west-1:123456789012:snapshot:aws-nuke-run-standalone</DBSnapshotArn>
+		</DBSnapshot></CreateDBSnapshotResult></CreateDBSnapshotResponse>`))
+	server.Handle("DescribeDBSnapshots",
+		awstest.XML(`<DescribeDBSnapshotsResponse><DescribeDBSnapshotsResult><DBSnapshots>
+			<DBSnapshot><Status>available</Status></DBSnapshot>
+		</DBSnapshots></DescribeDBSnapshotsResult></DescribeDBSnapshotsResponse>`))
+
+	resources, err := ListRDSInstances(server.Session("rds"))
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+
+	opts := SnapshotOptions{Name: "aws-nuke-run"}
+
+	// Aurora instances are covered by the snapshot of their cluster.
+	arn, err := resources[1].(*RDSInstance).Snapshot(context.Background(), opts)
+	require.NoError(t, err)
+	require.Empty(t, arn)
+	require.Empty(t, server.Requests("CreateDBSnapshot"))
+
+	arn, err = resources[0].(*RDSInstance).Snapshot(context.Background(), opts)
+	require.NoError(t, err)
+	require.Equal(t, "arn:aws:rds:eu-west-1:123456789012:snapshot:aws-nuke-run-standalone", arn)
+	require.Empty(t, server.Requests("DescribeDBSnapshots"))
+
+	err = resources[0].(*RDSInstance).WaitForSnapshot(context.Background(), arn, opts)
+	require.NoError(t, err)
+
+	creates := server.Requests("CreateDBSnapshot")
+	require.Len(t, creates, 1)
+	require.Equal(t, "standalone", creates[0].Params.Get("DBInstanceIdentifier"))
+	require.Equal(t, "aws-nuke-run-standalone", creates[0].Params.Get("DBSnapshotIdentifier"))
+
+	waits := server.Requests("DescribeDBSnapshots")
+	require.Len(t, waits, 1)
+	require.Equal(t, "aws-nuke-run-standalone", waits[0].Params.Get("DBSnapshotIdentifier"))
+}
@@ -17,7 +17,9 @@ type RDSOptionGroup struct {
 }
 
 func init() {
-	register("RDSOptionGroup", ListRDSOptionGroups)
+	register("RDSOptionGroup", ListRDSOptionGroups,
+		withProperties("Name", "tag:*"),
+		withFilter())
 }
 
 func ListRDSOptionGroups(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type RDSProxy struct {
 }
 
 func init() {
-	register("RDSProxy", ListRDSProxies)
+	register("RDSProxy", ListRDSProxies,
+		withProperties("ProxyName", "tag:*"))
 }
 
 func ListRDSProxies(sess *session.Session) ([]Resource, error) {
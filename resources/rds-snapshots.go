@@ -17,7 +17,9 @@ type RDSSnapshot struct {
 }
 
 func init() {
-	register("RDSSnapshot", ListRDSSnapshots)
+	register("RDSSnapshot", ListRDSSnapshots,
+		withProperties("ARN", "Identifier", "SnapshotType", "Status", "AvailabilityZone", "CreatedTime", "tag:*"),
+		withFilter())
 }
 
 func ListRDSSnapshots(sess *session.Session) ([]Resource, error) {
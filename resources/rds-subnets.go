@@ -14,7 +14,8 @@ type RDSDBSubnetGroup struct {
 }
 
 func init() {
-	register("RDSDBSubnetGroup", ListRDSSubnetGroups)
+	register("RDSDBSubnetGroup", ListRDSSubnetGroups,
+		withProperties("Name", "tag:*"))
 }
 
 func ListRDSSubnetGroups(sess *session.Session) ([]Resource, error) {
@@ -1,7 +1,12 @@
 package resources
 
 import (
+	"context"
+	"fmt"
+	"time"
+
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/redshift"
 	"github.com/rebuy-de/aws-nuke/v2/pkg/types"
@@ -13,7 +18,8 @@ type RedshiftCluster struct {
 }
 
 func init() {
-	register("RedshiftCluster", ListRedshiftClusters)
+	register("RedshiftCluster", ListRedshiftClusters,
+		withProperties("CreatedTime", "tag:*"))
 }
 
 func ListRedshiftClusters(sess *session.Session) ([]Resource, error) {
@@ -68,6 +74,42 @@ func (f *RedshiftCluster) Remove() error {
 	return err
 }
 
+func (f *RedshiftCluster) Snapshot(ctx context.Context, opts SnapshotOptions) (string, error) {
+	id := snapshotName(opts, *f.cluster.ClusterIdentifier)
+
+	tags := []*redshift.Tag{}
+	for _, key := range sortedTagKeys(opts.Tags) {
+		tags = append(tags, &redshift.Tag{Key: aws.String(key), Value: aws.String(opts.Tags[key])})
+	}
+
+	resp, err := f.svc.CreateClusterSnapshotWithContext(ctx, &redshift.CreateClusterSnapshotInput{
+		ClusterIdentifier:  f.cluster.ClusterIdentifier,
+		SnapshotIdentifier: aws.String(id),
+		Tags:               tags,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	// Redshift does not return the ARN of snapshots.
+	region := aws.StringValue(f.svc.Config.Region)
+	partition, _ := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), region)
+	return fmt.Sprintf("arn:%s:redshift:%s:%s:snapshot:%s/%s",
+		partition.ID(), region, aws.StringValue(resp.Snapshot.OwnerAccount),
+		aws.StringValue(f.cluster.ClusterIdentifier), id), nil
+}
+
+func (f *RedshiftCluster) WaitForSnapshot(ctx context.Context, arn string, opts SnapshotOptions) error {
+	return f.svc.WaitUntilSnapshotAvailableWithContext(ctx, &redshift.DescribeClusterSnapshotsInput{
+		ClusterIdentifier:  f.cluster.ClusterIdentifier,
+		SnapshotIdentifier: aws.String(arnResourceName(arn)),
+	})
+}
+
+func (f *RedshiftCluster) CreatedAt() *time.Time {
+	return f.cluster.ClusterCreateTime
+}
+
 func (f *RedshiftCluster) String() string {
 	return *f.cluster.ClusterIdentifier
 }
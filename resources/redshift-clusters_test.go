@@ -0,0 +1,44 @@
+package resources
+
+import (
+	"testing"
+
+	"github.com/rebuy-de/aws-nuke/v2/pkg/awstest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRedshiftClustersListAndRemove(t *testing.T) {
+	server := awstest.NewServer(t)
+	server.Handle("DescribeClusters",
+		awstest.XML(`<DescribeClustersResponse><DescribeClustersResult>
+			<Clusters><Cluster><ClusterIdentifier>alpha</ClusterIdentifier></Cluster></Clusters>
+			<Marker>page-2</Marker>
+		</DescribeClustersResult></DescribeClustersResponse>`),
+		awstest.XML(`<DescribeClustersResponse><DescribeClustersResult>
+			<Clusters><Cluster>
+				<ClusterIdentifier>beta</ClusterIdentifier>
+				<Tags><Tag><Key>owner</Key><Value>team-a</Value></Tag></Tags>
+			</Cluster></Clusters>
+		</DescribeClustersResult></DescribeClustersResponse>`))
+	server.Handle("DeleteCluster",
+		awstest.XML(`<DeleteClusterResponse><DeleteClusterResult><Cluster/></DeleteClusterResult></DeleteClusterResponse>`))
+
+	resources, err := ListRedshiftClusters(server.Session("redshift"))
+	require.NoError(t, err)
+	require.Len(t, resources, 2)
+
+	requests := server.Requests("DescribeClusters")
+	require.Len(t, requests, 2)
+	require.Equal(t, "page-2", requests[1].Params.Get("Marker"))
+
+	cluster := resources[1].(*RedshiftCluster)
+	require.Equal(t, "beta", cluster.String())
+	require.Equal(t, "team-a", cluster.Properties().Get("tag:owner"))
+
+	require.NoError(t, cluster.Remove())
+
+	deletes := server.Requests("DeleteCluster")
+	require.Len(t, deletes, 1)
+	require.Equal(t, "beta", deletes[0].Params.Get("ClusterIdentifier"))
+	require.Equal(t, "true", deletes[0].Params.Get("SkipFinalClusterSnapshot"))
+}
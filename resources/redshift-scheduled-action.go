@@ -12,7 +12,8 @@ type RedshiftScheduledAction struct {
 }
 
 func init() {
-	register("RedshiftScheduledAction", ListRedshiftScheduledActions)
+	register("RedshiftScheduledAction", ListRedshiftScheduledActions,
+		withProperties("scheduledActionName"))
 }
 
 func ListRedshiftScheduledActions(sess *session.Session) ([]Resource, error) {
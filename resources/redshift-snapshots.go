@@ -13,7 +13,8 @@ type RedshiftSnapshot struct {
 }
 
 func init() {
-	register("RedshiftSnapshot", ListRedshiftSnapshots)
+	register("RedshiftSnapshot", ListRedshiftSnapshots,
+		withProperties("CreatedTime", "tag:*"))
 }
 
 func ListRedshiftSnapshots(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type RedshiftServerlessNamespace struct {
 }
 
 func init() {
-	register("RedshiftServerlessNamespace", ListRedshiftServerlessNamespaces)
+	register("RedshiftServerlessNamespace", ListRedshiftServerlessNamespaces,
+		withProperties("CreationDate", "NamespaceName"))
 }
 
 func ListRedshiftServerlessNamespaces(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type RedshiftServerlessSnapshot struct {
 }
 
 func init() {
-	register("RedshiftServerlessSnapshot", ListRedshiftServerlessSnapshots)
+	register("RedshiftServerlessSnapshot", ListRedshiftServerlessSnapshots,
+		withProperties("CreateTime", "Namespace", "SnapshotName"))
 }
 
 func ListRedshiftServerlessSnapshots(sess *session.Session) ([]Resource, error) {
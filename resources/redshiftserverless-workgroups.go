@@ -13,7 +13,8 @@ type RedshiftServerlessWorkgroup struct {
 }
 
 func init() {
-	register("RedshiftServerlessWorkgroup", ListRedshiftServerlessWorkgroups)
+	register("RedshiftServerlessWorkgroup", ListRedshiftServerlessWorkgroups,
+		withProperties("CreationDate", "Namespace", "WorkgroupName"))
 }
 
 func ListRedshiftServerlessWorkgroups(sess *session.Session) ([]Resource, error) {
@@ -10,7 +10,8 @@ import (
 )
 
 func init() {
-	register("Route53HealthCheck", ListRoute53HealthChecks)
+	register("Route53HealthCheck", ListRoute53HealthChecks,
+		withProperties("ID"))
 }
 
 func ListRoute53HealthChecks(sess *session.Session) ([]Resource, error) {
@@ -10,7 +10,8 @@ import (
 )
 
 func init() {
-	register("Route53HostedZone", ListRoute53HostedZones)
+	register("Route53HostedZone", ListRoute53HostedZones,
+		withProperties("Name", "ID", "tag:*"))
 }
 
 func ListRoute53HostedZones(sess *session.Session) ([]Resource, error) {
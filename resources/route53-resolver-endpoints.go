@@ -16,7 +16,8 @@ type Route53ResolverEndpoint struct {
 }
 
 func init() {
-	register("Route53ResolverEndpoint", ListRoute53ResolverEndpoints)
+	register("Route53ResolverEndpoint", ListRoute53ResolverEndpoints,
+		withProperties("EndpointID", "Name"))
 }
 
 // ListRoute53ResolverEndpoints produces the resources to be nuked
@@ -20,7 +20,9 @@ type (
 )
 
 func init() {
-	register("Route53ResolverRule", ListRoute53ResolverRules)
+	register("Route53ResolverRule", ListRoute53ResolverRules,
+		withFilter(),
+		withProperties("ID", "Name"))
 }
 
 // ListRoute53ResolverRules produces the resources to be nuked.
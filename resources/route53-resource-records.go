@@ -19,7 +19,9 @@ type Route53ResourceRecordSet struct {
 }
 
 func init() {
-	register("Route53ResourceRecordSet", ListRoute53ResourceRecordSets)
+	register("Route53ResourceRecordSet", ListRoute53ResourceRecordSets,
+		withProperties("Name", "Type", "tag:hz:*"),
+		withFilter())
 }
 
 func ListRoute53ResourceRecordSets(sess *session.Session) ([]Resource, error) {
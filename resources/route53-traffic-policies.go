@@ -18,7 +18,8 @@ type Route53TrafficPolicy struct {
 }
 
 func init() {
-	register("Route53TrafficPolicy", ListRoute53TrafficPolicies)
+	register("Route53TrafficPolicy", ListRoute53TrafficPolicies,
+		withProperties("ID", "Name"))
 }
 
 func ListRoute53TrafficPolicies(sess *session.Session) ([]Resource, error) {
@@ -9,7 +9,8 @@ import (
 )
 
 func init() {
-	register("S3AccessPoint", ListS3AccessPoints)
+	register("S3AccessPoint", ListS3AccessPoints,
+		withProperties("AccessPointArn", "Alias", "Bucket", "Name", "NetworkOrigin"))
 }
 
 type S3AccessPoint struct {
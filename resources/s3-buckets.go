@@ -1,6 +1,7 @@
 package resources
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -16,8 +17,9 @@ import (
 )
 
 func init() {
-	register("S3Bucket", ListS3Buckets,
-		mapCloudControl("AWS::S3::Bucket"))
+	registerWithContext("S3Bucket", ListS3Buckets,
+		mapCloudControl("AWS::S3::Bucket"),
+		withProperties("Name", "CreationDate", "tag:*"))
 }
 
 type S3Bucket struct {
@@ -27,17 +29,17 @@ type S3Bucket struct {
 	tags         []*s3.Tag
 }
 
-func ListS3Buckets(s *session.Session) ([]Resource, error) {
+func ListS3Buckets(ctx context.Context, s *session.Session) ([]Resource, error) {
 	svc := s3.New(s)
 
-	buckets, err := DescribeS3Buckets(svc)
+	buckets, err := describeS3BucketsWithContext(ctx, svc)
 	if err != nil {
 		return nil, err
 	}
 
 	resources := make([]Resource, 0)
 	for _, bucket := range buckets {
-		tags, err := svc.GetBucketTagging(&s3.GetBucketTaggingInput{
+		tags, err := svc.GetBucketTaggingWithContext(ctx, &s3.GetBucketTaggingInput{
 			Bucket: bucket.Name,
 		})
 
@@ -67,14 +69,18 @@ func ListS3Buckets(s *session.Session) ([]Resource, error) {
 }
 
 func DescribeS3Buckets(svc *s3.S3) ([]s3.Bucket, error) {
-	resp, err := svc.ListBuckets(nil)
+	return describeS3BucketsWithContext(aws.BackgroundContext(), svc)
+}
+
+func describeS3BucketsWithContext(ctx context.Context, svc *s3.S3) ([]s3.Bucket, error) {
+	resp, err := svc.ListBucketsWithContext(ctx, &s3.ListBucketsInput{})
 	if err != nil {
 		return nil, err
 	}
 
 	buckets := make([]s3.Bucket, 0)
 	for _, out := range resp.Buckets {
-		bucketLocationResponse, err := svc.GetBucketLocation(&s3.GetBucketLocationInput{Bucket: out.Name})
+		bucketLocationResponse, err := svc.GetBucketLocationWithContext(ctx, &s3.GetBucketLocationInput{Bucket: out.Name})
 
 		if err != nil {
 			continue
@@ -91,14 +97,18 @@ func DescribeS3Buckets(svc *s3.S3) ([]s3.Bucket, error) {
 }
 
 func (e *S3Bucket) Remove() error {
-	_, err := e.svc.DeleteBucketPolicy(&s3.DeleteBucketPolicyInput{
+	return e.RemoveWithContext(aws.BackgroundContext())
+}
+
+func (e *S3Bucket) RemoveWithContext(ctx context.Context) error {
+	_, err := e.svc.DeleteBucketPolicyWithContext(ctx, &s3.DeleteBucketPolicyInput{
 		Bucket: &e.name,
 	})
 	if err != nil {
 		return err
 	}
 
-	_, err = e.svc.PutBucketLogging(&s3.PutBucketLoggingInput{
+	_, err = e.svc.PutBucketLoggingWithContext(ctx, &s3.PutBucketLoggingInput{
 		Bucket:              &e.name,
 		BucketLoggingStatus: &s3.BucketLoggingStatus{},
 	})
@@ -106,39 +116,39 @@ func (e *S3Bucket) Remove() error {
 		return err
 	}
 
-	err = e.RemoveAllVersions()
+	err = e.RemoveAllVersions(ctx)
 	if err != nil {
 		return err
 	}
 
-	err = e.RemoveAllObjects()
+	err = e.RemoveAllObjects(ctx)
 	if err != nil {
 		return err
 	}
 
-	_, err = e.svc.DeleteBucket(&s3.DeleteBucketInput{
+	_, err = e.svc.DeleteBucketWithContext(ctx, &s3.DeleteBucketInput{
 		Bucket: &e.name,
 	})
 
 	return err
 }
 
-func (e *S3Bucket) RemoveAllVersions() error {
+func (e *S3Bucket) RemoveAllVersions(ctx context.Context) error {
 	params := &s3.ListObjectVersionsInput{
 		Bucket: &e.name,
 	}
 
 	iterator := newS3DeleteVersionListIterator(e.svc, params)
-	return s3manager.NewBatchDeleteWithClient(e.svc).Delete(aws.BackgroundContext(), iterator)
+	return s3manager.NewBatchDeleteWithClient(e.svc).Delete(ctx, iterator)
 }
 
-func (e *S3Bucket) RemoveAllObjects() error {
+func (e *S3Bucket) RemoveAllObjects(ctx context.Context) error {
 	params := &s3.ListObjectsInput{
 		Bucket: &e.name,
 	}
 
 	iterator := s3manager.NewDeleteListIterator(e.svc, params)
-	return s3manager.NewBatchDeleteWithClient(e.svc).Delete(aws.BackgroundContext(), iterator)
+	return s3manager.NewBatchDeleteWithClient(e.svc).Delete(ctx, iterator)
 }
 
 func (e *S3Bucket) Properties() types.Properties {
@@ -153,6 +163,13 @@ func (e *S3Bucket) Properties() types.Properties {
 	return properties
 }
 
+func (e *S3Bucket) CreatedAt() *time.Time {
+	if e.creationDate.IsZero() {
+		return nil
+	}
+	return &e.creationDate
+}
+
 func (e *S3Bucket) String() string {
 	return fmt.Sprintf("s3://%s", e.name)
 }
@@ -17,7 +17,8 @@ type S3MultipartUpload struct {
 }
 
 func init() {
-	register("S3MultipartUpload", ListS3MultipartUpload)
+	register("S3MultipartUpload", ListS3MultipartUpload,
+		withProperties("Bucket", "Key", "UploadID"))
 }
 
 func ListS3MultipartUpload(sess *session.Session) ([]Resource, error) {
@@ -20,7 +20,8 @@ type S3Object struct {
 }
 
 func init() {
-	register("S3Object", ListS3Objects)
+	register("S3Object", ListS3Objects,
+		withProperties("Bucket", "Key", "VersionID", "IsLatest", "CreationDate"))
 }
 
 func ListS3Objects(sess *session.Session) ([]Resource, error) {
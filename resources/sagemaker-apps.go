@@ -19,7 +19,9 @@ type SageMakerApp struct {
 }
 
 func init() {
-	register("SageMakerApp", ListSageMakerApps)
+	register("SageMakerApp", ListSageMakerApps,
+		withFilter(),
+		withProperties("DomainID", "AppName", "AppType", "UserProfileName"))
 }
 
 func ListSageMakerApps(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type SageMakerDomain struct {
 }
 
 func init() {
-	register("SageMakerDomain", ListSageMakerDomains)
+	register("SageMakerDomain", ListSageMakerDomains,
+		withProperties("DomainID"))
 }
 
 func ListSageMakerDomains(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type SageMakerNotebookInstanceState struct {
 }
 
 func init() {
-	register("SageMakerNotebookInstanceState", ListSageMakerNotebookInstanceStates)
+	register("SageMakerNotebookInstanceState", ListSageMakerNotebookInstanceStates,
+		withFilter())
 }
 
 func ListSageMakerNotebookInstanceStates(sess *session.Session) ([]Resource, error) {
@@ -13,7 +13,8 @@ type SageMakerNotebookInstanceLifecycleConfig struct {
 }
 
 func init() {
-	register("SageMakerNotebookInstanceLifecycleConfig", ListSageMakerNotebookInstanceLifecycleConfigs)
+	register("SageMakerNotebookInstanceLifecycleConfig", ListSageMakerNotebookInstanceLifecycleConfigs,
+		withProperties("Name"))
 }
 
 func ListSageMakerNotebookInstanceLifecycleConfigs(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type SageMakerUserProfile struct {
 }
 
 func init() {
-	register("SageMakerUserProfiles", ListSageMakerUserProfiles)
+	register("SageMakerUserProfiles", ListSageMakerUserProfiles,
+		withProperties("DomainID", "UserProfileName"))
 }
 
 func ListSageMakerUserProfiles(sess *session.Session) ([]Resource, error) {
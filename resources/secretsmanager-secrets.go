@@ -14,7 +14,8 @@ type SecretsManagerSecret struct {
 }
 
 func init() {
-	register("SecretsManagerSecret", ListSecretsManagerSecrets)
+	register("SecretsManagerSecret", ListSecretsManagerSecrets,
+		withProperties("tag:*"))
 }
 
 func ListSecretsManagerSecrets(sess *session.Session) ([]Resource, error) {
@@ -7,7 +7,8 @@ import (
 )
 
 func init() {
-	register("SecurityHub", ListHubs)
+	register("SecurityHub", ListHubs,
+		withProperties("Arn"))
 }
 
 func ListHubs(sess *session.Session) ([]Resource, error) {
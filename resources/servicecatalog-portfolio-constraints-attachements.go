@@ -18,7 +18,8 @@ type ServiceCatalogConstraintPortfolioAttachment struct {
 }
 
 func init() {
-	register("ServiceCatalogConstraintPortfolioAttachment", ListServiceCatalogPrincipalProductAttachments)
+	register("ServiceCatalogConstraintPortfolioAttachment", ListServiceCatalogPrincipalProductAttachments,
+		withProperties("PortfolioID", "ConstraintID", "PortfolioName"))
 }
 
 func ListServiceCatalogPrincipalProductAttachments(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type ServiceCatalogPrincipalPortfolioAttachment struct {
 }
 
 func init() {
-	register("ServiceCatalogPrincipalPortfolioAttachment", ListServiceCatalogPrincipalPortfolioAttachments)
+	register("ServiceCatalogPrincipalPortfolioAttachment", ListServiceCatalogPrincipalPortfolioAttachments,
+		withProperties("PortfolioID", "PrincipalARN", "PortfolioName"))
 }
 
 func ListServiceCatalogPrincipalPortfolioAttachments(sess *session.Session) ([]Resource, error) {
@@ -18,7 +18,8 @@ type ServiceCatalogPortfolioProductAttachment struct {
 }
 
 func init() {
-	register("ServiceCatalogPortfolioProductAttachment", ListServiceCatalogPortfolioProductAttachments)
+	register("ServiceCatalogPortfolioProductAttachment", ListServiceCatalogPortfolioProductAttachments,
+		withProperties("PortfolioID", "PortfolioName", "ProductID", "ProductName"))
 }
 
 func ListServiceCatalogPortfolioProductAttachments(sess *session.Session) ([]Resource, error) {
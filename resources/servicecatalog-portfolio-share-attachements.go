@@ -17,7 +17,8 @@ type ServiceCatalogPortfolioShareAttachment struct {
 }
 
 func init() {
-	register("ServiceCatalogPortfolioShareAttachment", ListServiceCatalogPortfolioShareAttachments)
+	register("ServiceCatalogPortfolioShareAttachment", ListServiceCatalogPortfolioShareAttachments,
+		withProperties("PortfolioID", "PortfolioName", "AccountID"))
 }
 
 func ListServiceCatalogPortfolioShareAttachments(sess *session.Session) ([]Resource, error) {
@@ -20,7 +20,8 @@ type ServiceCatalogTagOptionPortfolioAttachment struct {
 }
 
 func init() {
-	register("ServiceCatalogTagOptionPortfolioAttachment", ListServiceCatalogTagOptionPortfolioAttachments)
+	register("ServiceCatalogTagOptionPortfolioAttachment", ListServiceCatalogTagOptionPortfolioAttachments,
+		withProperties("TagOptionID", "TagOptionKey", "TagOptionValue", "ResourceID", "ResourceName"))
 }
 
 func ListServiceCatalogTagOptionPortfolioAttachments(sess *session.Session) ([]Resource, error) {
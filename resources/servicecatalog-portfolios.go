@@ -15,7 +15,8 @@ type ServiceCatalogPortfolio struct {
 }
 
 func init() {
-	register("ServiceCatalogPortfolio", ListServiceCatalogPortfolios)
+	register("ServiceCatalogPortfolio", ListServiceCatalogPortfolios,
+		withProperties("ID", "DisplayName", "ProviderName"))
 }
 
 func ListServiceCatalogPortfolios(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type ServiceCatalogProduct struct {
 }
 
 func init() {
-	register("ServiceCatalogProduct", ListServiceCatalogProducts)
+	register("ServiceCatalogProduct", ListServiceCatalogProducts,
+		withProperties("ID", "Name"))
 }
 
 func ListServiceCatalogProducts(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type ServiceCatalogProvisionedProduct struct {
 }
 
 func init() {
-	register("ServiceCatalogProvisionedProduct", ListServiceCatalogProvisionedProducts)
+	register("ServiceCatalogProvisionedProduct", ListServiceCatalogProvisionedProducts,
+		withProperties("ID", "Name", "ProductID"))
 }
 
 func ListServiceCatalogProvisionedProducts(sess *session.Session) ([]Resource, error) {
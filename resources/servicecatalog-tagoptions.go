@@ -16,7 +16,8 @@ type ServiceCatalogTagOption struct {
 }
 
 func init() {
-	register("ServiceCatalogTagOption", ListServiceCatalogTagOptions)
+	register("ServiceCatalogTagOption", ListServiceCatalogTagOptions,
+		withProperties("ID", "Key", "Value"))
 }
 
 func ListServiceCatalogTagOptions(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type SESReceiptRuleSet struct {
 }
 
 func init() {
-	register("SESReceiptRuleSet", ListSESReceiptRuleSets)
+	register("SESReceiptRuleSet", ListSESReceiptRuleSets,
+		withFilter())
 }
 
 func ListSESReceiptRuleSets(sess *session.Session) ([]Resource, error) {
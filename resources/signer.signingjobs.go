@@ -25,7 +25,9 @@ type SignerSigningJob struct {
 }
 
 func init() {
-	register("SignerSigningJob", ListSignerSigningJobs)
+	register("SignerSigningJob", ListSignerSigningJobs,
+		withProperties("JobId", "CreatedAt", "ProfileName", "ProfileVersion", "PlatformId", "PlatformDisplayName", "JobOwner", "JobInvoker"),
+		withFilter())
 }
 
 func ListSignerSigningJobs(sess *session.Session) ([]Resource, error) {
@@ -0,0 +1,123 @@
+package resources
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/backup"
+)
+
+var snapshotNameInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// snapshotName builds a snapshot name from the prefix and the resource ID,
+// which is valid for all services: it starts with a letter, only contains
+// letters, digits and single hyphens and is at most 255 characters long.
+func snapshotName(opts SnapshotOptions, id string) string {
+	name := snapshotNameInvalidChars.ReplaceAllString(opts.Name+"-"+id, "-")
+	name = strings.Trim(name, "-")
+	if len(name) > 255 {
+		name = strings.TrimRight(name[:255], "-")
+	}
+	if name == "" || !(name[0] >= 'a' && name[0] <= 'z' || name[0] >= 'A' && name[0] <= 'Z') {
+		name = "snapshot-" + name
+	}
+
+	return name
+}
+
+// sortedTagKeys returns the tag keys in a stable order, so the tags are
+// always created the same way.
+func sortedTagKeys(tags map[string]string) []string {
+	keys := make([]string, 0, len(tags))
+	for key := range tags {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// arnResourceName returns the last part of the resource of an ARN, which is
+// the name or ID of snapshots.
+func arnResourceName(arn string) string {
+	return arn[strings.LastIndexAny(arn, ":/")+1:]
+}
+
+// backupResource starts an AWS Backup job for the resource and returns the
+// ARN of its recovery point. It is used for resources without native
+// snapshots.
+func backupResource(ctx context.Context, svc *backup.Backup, resourceARN string, opts SnapshotOptions) (string, error) {
+	if opts.BackupRoleARN == "" {
+		return "", fmt.Errorf("backup-role-arn must be configured to back up %s", resourceARN)
+	}
+
+	tags := map[string]*string{}
+	for key, value := range opts.Tags {
+		tags[key] = aws.String(value)
+	}
+
+	job, err := svc.StartBackupJobWithContext(ctx, &backup.StartBackupJobInput{
+		BackupVaultName:   aws.String(backupVault(opts)),
+		IamRoleArn:        aws.String(opts.BackupRoleARN),
+		ResourceArn:       aws.String(resourceARN),
+		RecoveryPointTags: tags,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return aws.StringValue(job.RecoveryPointArn), nil
+}
+
+// waitForBackup waits for the backup job, which creates the recovery point.
+// The job is looked up by the recovery point, since only its ARN is kept
+// between retries.
+func waitForBackup(ctx context.Context, svc *backup.Backup, resourceARN, recoveryPointARN string, opts SnapshotOptions) error {
+	for {
+		var job *backup.Job
+		err := svc.ListBackupJobsPagesWithContext(ctx, &backup.ListBackupJobsInput{
+			ByBackupVaultName: aws.String(backupVault(opts)),
+			ByResourceArn:     aws.String(resourceARN),
+		}, func(page *backup.ListBackupJobsOutput, lastPage bool) bool {
+			for _, j := range page.BackupJobs {
+				if aws.StringValue(j.RecoveryPointArn) == recoveryPointARN {
+					job = j
+					return false
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return err
+		}
+
+		// A new job might not be listed yet.
+		if job != nil {
+			switch aws.StringValue(job.State) {
+			case backup.JobStateCompleted:
+				return nil
+			case backup.JobStateAborted, backup.JobStateFailed, backup.JobStateExpired:
+				return fmt.Errorf("backup job %s is %s: %s",
+					aws.StringValue(job.BackupJobId), aws.StringValue(job.State), aws.StringValue(job.StatusMessage))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(15 * time.Second):
+		}
+	}
+}
+
+func backupVault(opts SnapshotOptions) string {
+	if opts.BackupVault == "" {
+		return "Default"
+	}
+
+	return opts.BackupVault
+}
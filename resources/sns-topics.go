@@ -15,7 +15,8 @@ type SNSTopic struct {
 }
 
 func init() {
-	register("SNSTopic", ListSNSTopics)
+	register("SNSTopic", ListSNSTopics,
+		withProperties("TopicARN", "tag:*"))
 }
 
 func ListSNSTopics(sess *session.Session) ([]Resource, error) {
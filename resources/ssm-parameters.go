@@ -14,7 +14,8 @@ type SSMParameter struct {
 }
 
 func init() {
-	register("SSMParameter", ListSSMParameters)
+	register("SSMParameter", ListSSMParameters,
+		withProperties("Name", "tag:*"))
 }
 
 func ListSSMParameters(sess *session.Session) ([]Resource, error) {
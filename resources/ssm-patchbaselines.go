@@ -15,7 +15,8 @@ type SSMPatchBaseline struct {
 }
 
 func init() {
-	register("SSMPatchBaseline", ListSSMPatchBaselines)
+	register("SSMPatchBaseline", ListSSMPatchBaselines,
+		withFilter())
 }
 
 func ListSSMPatchBaselines(sess *session.Session) ([]Resource, error) {
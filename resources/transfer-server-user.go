@@ -17,7 +17,8 @@ type TransferServerUser struct {
 }
 
 func init() {
-	register("TransferServerUser", ListTransferServerUsers)
+	register("TransferServerUser", ListTransferServerUsers,
+		withProperties("Username", "ServerID", "tag:*"))
 }
 
 func ListTransferServerUsers(sess *session.Session) ([]Resource, error) {
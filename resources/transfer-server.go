@@ -18,7 +18,8 @@ type TransferServer struct {
 }
 
 func init() {
-	register("TransferServer", ListTransferServers)
+	register("TransferServer", ListTransferServers,
+		withProperties("ServerID", "EndpointType", "Protocols", "tag:*"))
 }
 
 func ListTransferServers(sess *session.Session) ([]Resource, error) {
@@ -14,7 +14,8 @@ type WAFRule struct {
 }
 
 func init() {
-	register("WAFRule", ListWAFRules)
+	register("WAFRule", ListWAFRules,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRules(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type WAFRegionalByteMatchSetIP struct {
 }
 
 func init() {
-	register("WAFRegionalByteMatchSetIP", ListWAFRegionalByteMatchSetIPs)
+	register("WAFRegionalByteMatchSetIP", ListWAFRegionalByteMatchSetIPs,
+		withProperties("ByteMatchSetID", "FieldToMatchType", "FieldToMatchData", "TargetString"))
 }
 
 func ListWAFRegionalByteMatchSetIPs(sess *session.Session) ([]Resource, error) {
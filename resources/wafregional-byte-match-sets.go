@@ -15,7 +15,8 @@ type WAFRegionalByteMatchSet struct {
 }
 
 func init() {
-	register("WAFRegionalByteMatchSet", ListWAFRegionalByteMatchSets)
+	register("WAFRegionalByteMatchSet", ListWAFRegionalByteMatchSets,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRegionalByteMatchSets(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type WAFRegionalIPSetIP struct {
 }
 
 func init() {
-	register("WAFRegionalIPSetIP", ListWAFRegionalIPSetIPs)
+	register("WAFRegionalIPSetIP", ListWAFRegionalIPSetIPs,
+		withProperties("IPSetID", "Type", "Value"))
 }
 
 func ListWAFRegionalIPSetIPs(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type WAFRegionalIPSet struct {
 }
 
 func init() {
-	register("WAFRegionalIPSet", ListWAFRegionalIPSets)
+	register("WAFRegionalIPSet", ListWAFRegionalIPSets,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRegionalIPSets(sess *session.Session) ([]Resource, error) {
@@ -16,7 +16,8 @@ type WAFRegionalRateBasedRulePredicate struct {
 }
 
 func init() {
-	register("WAFRegionalRateBasedRulePredicate", ListWAFRegionalRateBasedRulePredicates)
+	register("WAFRegionalRateBasedRulePredicate", ListWAFRegionalRateBasedRulePredicates,
+		withProperties("RuleID", "Type", "Negated", "DataID"))
 }
 
 func ListWAFRegionalRateBasedRulePredicates(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type WAFRegionalRegexMatchSet struct {
 }
 
 func init() {
-	register("WAFRegionalRegexMatchSet", ListWAFRegionalRegexMatchSet)
+	register("WAFRegionalRegexMatchSet", ListWAFRegionalRegexMatchSet,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRegionalRegexMatchSet(sess *session.Session) ([]Resource, error) {
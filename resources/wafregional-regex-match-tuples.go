@@ -15,7 +15,8 @@ type WAFRegionalRegexMatchTuple struct {
 }
 
 func init() {
-	register("WAFRegionalRegexMatchTuple", ListWAFRegionalRegexMatchTuple)
+	register("WAFRegionalRegexMatchTuple", ListWAFRegionalRegexMatchTuple,
+		withProperties("RegexMatchSetID", "FieldToMatchType", "FieldToMatchData", "TextTransformation"))
 }
 
 func ListWAFRegionalRegexMatchTuple(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type WAFRegionalRegexPatternSet struct {
 }
 
 func init() {
-	register("WAFRegionalRegexPatternSet", ListWAFRegionalRegexPatternSet)
+	register("WAFRegionalRegexPatternSet", ListWAFRegionalRegexPatternSet,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRegionalRegexPatternSet(sess *session.Session) ([]Resource, error) {
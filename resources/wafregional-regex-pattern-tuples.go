@@ -15,7 +15,8 @@ type WAFRegionalRegexPatternString struct {
 }
 
 func init() {
-	register("WAFRegionalRegexPatternString", ListWAFRegionalRegexPatternString)
+	register("WAFRegionalRegexPatternString", ListWAFRegionalRegexPatternString,
+		withProperties("RegexPatternSetID", "patternString"))
 }
 
 func ListWAFRegionalRegexPatternString(sess *session.Session) ([]Resource, error) {
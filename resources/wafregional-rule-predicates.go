@@ -15,7 +15,8 @@ type WAFRegionalRulePredicate struct {
 }
 
 func init() {
-	register("WAFRegionalRulePredicate", ListWAFRegionalRulePredicates)
+	register("WAFRegionalRulePredicate", ListWAFRegionalRulePredicates,
+		withProperties("RuleID", "Type", "Negated", "DataID"))
 }
 
 func ListWAFRegionalRulePredicates(sess *session.Session) ([]Resource, error) {
@@ -15,7 +15,8 @@ type WAFRegionalRuleGroup struct {
 }
 
 func init() {
-	register("WAFRegionalRuleGroup", ListWAFRegionalRuleGroups)
+	register("WAFRegionalRuleGroup", ListWAFRegionalRuleGroups,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRegionalRuleGroups(sess *session.Session) ([]Resource, error) {
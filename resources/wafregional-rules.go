@@ -16,7 +16,8 @@ type WAFRegionalRule struct {
 }
 
 func init() {
-	register("WAFRegionalRule", ListWAFRegionalRules)
+	register("WAFRegionalRule", ListWAFRegionalRules,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRegionalRules(sess *session.Session) ([]Resource, error) {
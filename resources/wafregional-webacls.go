@@ -15,7 +15,8 @@ type WAFRegionalWebACL struct {
 }
 
 func init() {
-	register("WAFRegionalWebACL", ListWAFRegionalWebACLs)
+	register("WAFRegionalWebACL", ListWAFRegionalWebACLs,
+		withProperties("ID", "Name"))
 }
 
 func ListWAFRegionalWebACLs(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type WAFv2IPSet struct {
 
 func init() {
 	register("WAFv2IPSet", ListWAFv2IPSets,
-		mapCloudControl("AWS::WAFv2::IPSet"))
+		mapCloudControl("AWS::WAFv2::IPSet"),
+		withProperties("ID", "Name", "Scope"))
 }
 
 func ListWAFv2IPSets(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type WAFv2RegexPatternSet struct {
 
 func init() {
 	register("WAFv2RegexPatternSet", ListWAFv2RegexPatternSets,
-		mapCloudControl("AWS::WAFv2::RegexPatternSet"))
+		mapCloudControl("AWS::WAFv2::RegexPatternSet"),
+		withProperties("ID", "Name", "Scope"))
 }
 
 func ListWAFv2RegexPatternSets(sess *session.Session) ([]Resource, error) {
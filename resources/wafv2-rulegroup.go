@@ -17,7 +17,8 @@ type WAFv2RuleGroup struct {
 
 func init() {
 	register("WAFv2RuleGroup", ListWAFv2RuleGroups,
-		mapCloudControl("AWS::WAFv2::RuleGroup"))
+		mapCloudControl("AWS::WAFv2::RuleGroup"),
+		withProperties("ID", "Name", "Scope"))
 }
 
 func ListWAFv2RuleGroups(sess *session.Session) ([]Resource, error) {
@@ -17,7 +17,8 @@ type WAFv2WebACL struct {
 
 func init() {
 	register("WAFv2WebACL", ListWAFv2WebACLs,
-		mapCloudControl("AWS::WAFv2::WebACL"))
+		mapCloudControl("AWS::WAFv2::WebACL"),
+		withProperties("ID", "Name", "Scope"))
 }
 
 func ListWAFv2WebACLs(sess *session.Session) ([]Resource, error) {